@@ -4,6 +4,10 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/labd/amplience-go-sdk/content"
+	"github.com/labd/terraform-provider-amplience/internal/api"
+	"github.com/labd/terraform-provider-amplience/internal/config"
+	"github.com/labd/terraform-provider-amplience/internal/utils"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -21,15 +25,30 @@ func dataSourceContentRepository() *schema.Resource {
 		ReadContext: dataSourceContentRepositoryRead,
 		Schema: map[string]*schema.Schema{
 			"id": {
+				Description:      "ID of the Content Repository. Exactly one of `id` and `name` must be set",
 				Type:             schema.TypeString,
-				Required:         true,
+				Optional:         true,
+				Computed:         true,
+				ExactlyOneOf:     []string{"id", "name"},
 				ValidateDiagFunc: ValidateDiagWrapper(validation.StringDoesNotContainAny(" ")),
 			},
-			"label": {
-				Type:     schema.TypeString,
-				Computed: true,
-			},
 			"name": {
+				Description:      "Name of the Content Repository, which is unique within the hub",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ExactlyOneOf:     []string{"id", "name"},
+				ValidateDiagFunc: ValidateDiagWrapper(validation.StringDoesNotContainAny(" ")),
+			},
+			"hub_id": {
+				Description: "ID of the Hub to look up the Content Repository by name in. Defaults to the `hub_id` " +
+					"of the provider. For a lookup by `id` this is the Hub the Content Repository belongs to",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: ValidateDiagWrapper(validation.StringDoesNotContainAny(" ")),
+			},
+			"label": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
@@ -38,17 +57,42 @@ func dataSourceContentRepository() *schema.Resource {
 }
 
 func dataSourceContentRepositoryRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	var diags diag.Diagnostics
 	ci := getClient(meta)
 
-	repository_id := data.Get("id").(string)
-	repository, err := ci.Client.ContentRepositoryGet(repository_id)
+	var repository content.ContentRepository
+	var hubID string
+	var err error
+	if name, ok := data.GetOk("name"); ok {
+		hubID, err = requireHubID(data, ci)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		repository, err = findContentRepositoryByName(ctx, ci, hubID, name.(string))
+	} else {
+		repository, err = ci.Client.ContentRepositoryGet(data.Get("id").(string))
+		hubID = api.ContentRepositoryHubID(repository)
+	}
 	if err != nil {
-		return diag.FromErr(err)
+		return apiErrorDiagnostics("Failed to read content repository", err)
 	}
 
 	data.SetId(repository.ID)
 	data.Set("label", repository.Label)
 	data.Set("name", repository.Name)
-	return diags
+	data.Set("hub_id", hubID)
+	return nil
+}
+
+// findContentRepositoryByName returns the content repository of the hub with the given name
+func findContentRepositoryByName(ctx context.Context, ci *config.ClientInfo, hubID string, name string) (content.ContentRepository, error) {
+	repositories, err := ci.API.ContentRepositoryGetAll(ctx, hubID)
+	if err != nil {
+		return content.ContentRepository{}, err
+	}
+
+	repository, err := utils.FindUnique(repositories,
+		func(r api.ContentRepository) string { return r.Name },
+		func(r api.ContentRepository) string { return r.ID },
+		name, "content repository", "name")
+	return content.ContentRepository{ID: repository.ID, Name: repository.Name, Label: repository.Label}, err
 }
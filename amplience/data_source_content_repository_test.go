@@ -0,0 +1,89 @@
+package amplience
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/labd/terraform-provider-amplience/internal/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testContentRepositoriesHandler(t *testing.T) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/content-repositories/repository-id":
+			testutils.WriteJSON(w, http.StatusOK, map[string]interface{}{
+				"id":    "repository-id",
+				"name":  "content",
+				"label": "Content",
+				"_links": map[string]interface{}{
+					"hub": map[string]interface{}{"href": "https://api.amplience.net/v2/content/hubs/other-hub"},
+				},
+			})
+		case "/hubs/" + testutils.HubID + "/content-repositories":
+			testutils.WriteJSON(w, http.StatusOK, map[string]interface{}{
+				"_embedded": map[string]interface{}{
+					"content-repositories": []map[string]interface{}{
+						{"id": "other-id", "name": "slots", "label": "Slots"},
+						{"id": "repository-id", "name": "content", "label": "Content"},
+					},
+				},
+				"page": map[string]interface{}{"totalPages": 1},
+			})
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+			testutils.NotFound(w, r)
+		}
+	}
+}
+
+func TestDataSourceContentRepositoryRead(t *testing.T) {
+	t.Parallel()
+	tcs := []struct {
+		Name   string
+		Config map[string]interface{}
+		HubID  string
+		Error  string
+	}{
+		{
+			Name:   "Looks up the repository by ID in the hub it belongs to",
+			Config: map[string]interface{}{"id": "repository-id"},
+			HubID:  "other-hub",
+		},
+		{
+			Name:   "Looks up the repository by name",
+			Config: map[string]interface{}{"name": "content"},
+			HubID:  testutils.HubID,
+		},
+		{
+			Name:   "Fails when no repository has the name",
+			Config: map[string]interface{}{"name": "missing"},
+			Error:  `no content repository found with name "missing"`,
+		},
+	}
+
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			ci := testutils.NewClientInfo(t, testContentRepositoriesHandler(t))
+
+			data := schema.TestResourceDataRaw(t, dataSourceContentRepository().Schema, tc.Config)
+			diags := dataSourceContentRepositoryRead(context.Background(), data, ci)
+			if tc.Error != "" {
+				require.True(t, diags.HasError())
+				assert.Contains(t, diags[0].Detail, tc.Error)
+				return
+			}
+
+			require.False(t, diags.HasError(), "%v", diags)
+			assert.Equal(t, "repository-id", data.Id())
+			assert.Equal(t, "content", data.Get("name"))
+			assert.Equal(t, "Content", data.Get("label"))
+			assert.Equal(t, tc.HubID, data.Get("hub_id"))
+		})
+	}
+}
@@ -3,90 +3,108 @@ package amplience
 import (
 	"context"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
-	"github.com/labd/amplience-go-sdk/content"
+	"github.com/labd/terraform-provider-amplience/internal/config"
 )
 
 // Provider -
-func Provider() *schema.Provider {
+func Provider(version string) *schema.Provider {
 	return &schema.Provider{
-		Schema: map[string]*schema.Schema{
-			"client_id": {
-				Description: "The OAuth Client ID for the Amplience management API https://amplience_provider.com/docs/api/dynamic-content/management/index.html#section/Authentication",
-				Type:        schema.TypeString,
-				Required:    true,
-				DefaultFunc: schema.EnvDefaultFunc("AMPLIENCE_CLIENT_ID", nil),
-				Sensitive:   true,
-			},
-			"client_secret": {
-				Description: "The OAuth Client Secret for Amplience management API. https://amplience_provider.com/docs/api/dynamic-content/management/index.html#section/Authentication",
-				Type:        schema.TypeString,
-				Required:    true,
-				DefaultFunc: schema.EnvDefaultFunc("AMPLIENCE_CLIENT_SECRET", nil),
-				Sensitive:   true,
-			},
-			"content_api_url": {
-				Description: "The base URL path for the Amplience Content API",
-				Type:        schema.TypeString,
-				Required:    true,
-				DefaultFunc: schema.EnvDefaultFunc("AMPLIENCE_CONTENT_API_URL", "https://api.amplience.net/v2/content"),
-				Sensitive:   false,
-			},
-			"auth_url": {
-				Description: "The Amplience authentication URL",
-				Type:        schema.TypeString,
-				Required:    true,
-				DefaultFunc: schema.EnvDefaultFunc("AMPLIENCE_AUTH_URL", "https://auth.adis.ws/oauth/token"),
-				Sensitive:   false,
-			},
-			"hub_id": {
-				Description:      "ID of the Hub to manage",
-				Type:             schema.TypeString,
-				Required:         true,
-				DefaultFunc:      schema.EnvDefaultFunc("AMPLIENCE_HUB_ID", nil),
-				ValidateDiagFunc: ValidateDiagWrapper(validation.StringDoesNotContainAny(" ")),
-			},
-		},
+		Schema: providerSchema(),
 		ResourcesMap: map[string]*schema.Resource{
 			"amplience_content_repository":      resourceContentRepository(),
 			"amplience_content_type":            resourceContentType(),
 			"amplience_content_type_assignment": resourceContentTypeAssignment(),
-			"amplience_content_type_schema":     resourceContentTypeSchema(),
 			"amplience_webhook":                 resourceWebhook(),
 			"amplience_search_index":            resourceSearchIndex(),
 		},
 		DataSourcesMap: map[string]*schema.Resource{
-			"amplience_hub":                dataSourceHub(),
 			"amplience_content_repository": dataSourceContentRepository(),
 		},
-		ConfigureContextFunc: providerConfigure,
+		ConfigureContextFunc: providerConfigure(version),
+	}
+}
+
+// providerSchema builds the provider schema from the attributes shared with the framework provider. Everything is
+// optional, since values can also come from environment variables. Validation is done by config.Resolve.
+func providerSchema() map[string]*schema.Schema {
+	result := map[string]*schema.Schema{}
+	for _, attribute := range config.Attributes {
+		s := &schema.Schema{
+			Description: attribute.FullDescription(),
+			Optional:    true,
+			Sensitive:   attribute.Sensitive,
+		}
+		switch attribute.Type {
+		case config.TypeString:
+			s.Type = schema.TypeString
+		case config.TypeInt:
+			s.Type = schema.TypeInt
+		}
+		result[attribute.Name] = s
 	}
+	return result
 }
 
-func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
-	clientID := d.Get("client_id").(string)
-	clientSecret := d.Get("client_secret").(string)
-	apiURL := d.Get("content_api_url").(string)
-	authURL := d.Get("auth_url").(string)
+func providerConfigure(version string) schema.ConfigureContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+		var diags diag.Diagnostics
+
+		// Use the raw config instead of GetOk, so explicitly configured zero values (e.g. max_retries = 0) are kept
+		raw := d.GetRawConfig()
+
+		cfg, errs := config.Resolve(config.RawConfig{
+			ClientID:      rawString(raw, "client_id"),
+			ClientSecret:  rawString(raw, "client_secret"),
+			ContentAPIURL: rawString(raw, "content_api_url"),
+			AuthURL:       rawString(raw, "auth_url"),
+			HubID:         rawString(raw, "hub_id"),
+			MaxRetries:    rawInt64(raw, "max_retries"),
+			RetryMaxWait:  rawInt64(raw, "retry_max_wait"),
+		})
+		for _, err := range errs {
+			diags = append(diags, diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       err.Summary,
+				Detail:        err.Detail,
+				AttributePath: cty.GetAttrPath(err.Attribute),
+			})
+		}
+		if diags.HasError() {
+			return nil, diags
+		}
 
-	var diags diag.Diagnostics
+		clientInfo, err := config.NewClientInfo(cfg, version)
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
 
-	client, err := content.NewClient(&content.ClientConfig{
-		ClientID:     clientID,
-		ClientSecret: clientSecret,
-		URL:          apiURL,
-		AuthURL:      authURL,
-	})
-	if err != nil {
-		return nil, diag.FromErr(err)
+		return clientInfo, diags
 	}
+}
 
-	clientInfo := &ClientInfo{
-		Client: client,
-		HubID:  d.Get("hub_id").(string),
+func rawString(raw cty.Value, name string) *string {
+	if raw.IsNull() || !raw.IsKnown() {
+		return nil
+	}
+	value := raw.GetAttr(name)
+	if value.IsNull() || !value.IsKnown() {
+		return nil
 	}
+	result := value.AsString()
+	return &result
+}
 
-	return clientInfo, diags
+func rawInt64(raw cty.Value, name string) *int64 {
+	if raw.IsNull() || !raw.IsKnown() {
+		return nil
+	}
+	value := raw.GetAttr(name)
+	if value.IsNull() || !value.IsKnown() {
+		return nil
+	}
+	result, _ := value.AsBigFloat().Int64()
+	return &result
 }
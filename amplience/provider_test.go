@@ -13,7 +13,7 @@ var testAccProvider *schema.Provider
 var testAccProviders map[string]*schema.Provider
 
 func init() {
-	testAccProvider = Provider()
+	testAccProvider = Provider("testing")
 	testAccProviders = map[string]*schema.Provider{
 		"amplience": testAccProvider,
 	}
@@ -2,9 +2,14 @@ package amplience
 
 import (
 	"context"
+	"fmt"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/labd/amplience-go-sdk/content"
+	"github.com/labd/terraform-provider-amplience/internal/api"
+	"github.com/labd/terraform-provider-amplience/internal/config"
+	"github.com/labd/terraform-provider-amplience/internal/utils"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -24,9 +29,10 @@ func resourceContentRepository() *schema.Resource {
 		UpdateContext: resourceContentRepositoryUpdate,
 		DeleteContext: resourceContentRepositoryDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importHubScopedResourceByKey(getContentRepository, findContentRepositoryIDByName),
 		},
 		Schema: map[string]*schema.Schema{
+			"hub_id": hubIDSchema(),
 			"name": {
 				Type:             schema.TypeString,
 				Required:         true,
@@ -36,6 +42,17 @@ func resourceContentRepository() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"content_type_ids": {
+				Description: "IDs of the Content Types that are assigned to the Repository. When set, this list is " +
+					"authoritative: Content Types assigned outside of Terraform are removed from the Repository, and an " +
+					"empty list removes all Content Types. When omitted, the assigned Content Types are not managed. Do " +
+					"not combine this with `amplience_content_type_assignment` resources for the same Repository",
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 		},
 	}
 }
@@ -49,15 +66,27 @@ func resourceContentRepositoryCreate(ctx context.Context, data *schema.ResourceD
 		Label: data.Get("label").(string),
 	}
 
-	repository, err := ci.Client.ContentRepositoryCreate(ci.HubID, input)
-
+	hubID, err := requireHubID(data, ci)
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	repository, err := ci.Client.ContentRepositoryCreate(hubID, input)
 
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	data.SetId(repository.ID)
-	data.Set("name", repository.Name)
-	data.Set("label", repository.Label)
+
+	managed := contentTypeIDsManaged(data.GetRawConfig())
+	if managed {
+		repository, err = reconcileContentRepositoryContentTypes(ci, repository, data.Get("content_type_ids").(*schema.Set))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	data.Set("hub_id", hubID)
+	resourceContentRepositorySaveState(data, repository, managed)
 	return diags
 }
 
@@ -69,11 +98,19 @@ func resourceContentRepositoryRead(ctx context.Context, data *schema.ResourceDat
 
 	repository, err := ci.Client.ContentRepositoryGet(repository_id)
 	if err != nil {
+		if utils.IsNotFound(err) {
+			return removeFromState(data, "content repository")
+		}
 		return diag.FromErr(err)
 	}
 
-	data.Set("name", repository.Name)
-	data.Set("label", repository.Label)
+	// A repository imported by its ID is in the hub it links to, which is not necessarily the hub of the provider
+	hubID := api.ContentRepositoryHubID(repository)
+	if hubID == "" {
+		hubID = getHubID(data, ci)
+	}
+	data.Set("hub_id", hubID)
+	resourceContentRepositorySaveState(data, repository, contentTypeIDsManaged(data.GetRawState()))
 	return diags
 }
 
@@ -83,26 +120,37 @@ func resourceContentRepositoryUpdate(ctx context.Context, data *schema.ResourceD
 
 	repository_id := data.Id()
 
-	if data.HasChange("label") || data.HasChange("name") {
-		current, err := ci.Client.ContentRepositoryGet(repository_id)
-		if err != nil {
-			return diag.FromErr(err)
-		}
+	if !data.HasChanges("label", "name", "content_type_ids") {
+		return diags
+	}
 
+	repository, err := ci.Client.ContentRepositoryGet(repository_id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if data.HasChange("label") || data.HasChange("name") {
 		input := content.ContentRepositoryInput{
 			Name:  data.Get("name").(string),
 			Label: data.Get("label").(string),
 		}
 
-		repository, err := ci.Client.ContentRepositoryUpdate(current, input)
+		repository, err = ci.Client.ContentRepositoryUpdate(repository, input)
 		if err != nil {
 			return diag.FromErr(err)
 		}
+	}
 
-		data.Set("label", repository.Label)
-		data.Set("name", repository.Name)
+	// Removing content_type_ids from the config stops managing the assignments, it does not remove them
+	managed := contentTypeIDsManaged(data.GetRawConfig())
+	if managed && data.HasChange("content_type_ids") {
+		repository, err = reconcileContentRepositoryContentTypes(ci, repository, data.Get("content_type_ids").(*schema.Set))
+		if err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
+	resourceContentRepositorySaveState(data, repository, managed)
 	return diags
 }
 
@@ -112,3 +160,74 @@ func resourceContentRepositoryDelete(ctx context.Context, data *schema.ResourceD
 	data.SetId("")
 	return diags
 }
+
+func resourceContentRepositorySaveState(data *schema.ResourceData, repository content.ContentRepository, manageContentTypes bool) {
+	data.Set("name", repository.Name)
+	data.Set("label", repository.Label)
+
+	if !manageContentTypes {
+		data.Set("content_type_ids", nil)
+		return
+	}
+
+	contentTypeIDs := make([]interface{}, len(repository.ContentTypes))
+	for i, contentType := range repository.ContentTypes {
+		contentTypeIDs[i] = contentType.HubContentTypeID
+	}
+	data.Set("content_type_ids", schema.NewSet(schema.HashString, contentTypeIDs))
+}
+
+// contentTypeIDsManaged returns whether content_type_ids is set in raw, the config or the state of a repository. An
+// empty set is managed as well: it removes all assigned Content Types.
+func contentTypeIDsManaged(raw cty.Value) bool {
+	if raw.IsNull() || !raw.IsKnown() {
+		return false
+	}
+	return !raw.GetAttr("content_type_ids").IsNull()
+}
+
+// reconcileContentRepositoryContentTypes assigns and removes Content Types so that the repository offers exactly the
+// given Content Types, and returns the resulting repository
+func reconcileContentRepositoryContentTypes(ci *config.ClientInfo, repository content.ContentRepository, contentTypeIDs *schema.Set) (content.ContentRepository, error) {
+	repositoryID := repository.ID
+	current := make(map[string]bool, len(repository.ContentTypes))
+	for _, contentType := range repository.ContentTypes {
+		current[contentType.HubContentTypeID] = true
+	}
+
+	var err error
+	for id := range current {
+		if contentTypeIDs.Contains(id) {
+			continue
+		}
+		repository, err = ci.Client.ContentRepositoryRemoveContentType(repositoryID, id)
+		if err != nil {
+			return repository, fmt.Errorf("failed to remove content type %s from repository %s: %w", id, repositoryID, err)
+		}
+	}
+
+	for _, item := range contentTypeIDs.List() {
+		id := item.(string)
+		if current[id] {
+			continue
+		}
+		repository, err = ci.Client.ContentRepositoryAssignContentType(repositoryID, id)
+		if err != nil {
+			return repository, fmt.Errorf("failed to assign content type %s to repository %s: %w", id, repositoryID, err)
+		}
+	}
+
+	return repository, nil
+}
+
+func getContentRepository(ci *config.ClientInfo, id string) error {
+	_, err := ci.Client.ContentRepositoryGet(id)
+	return err
+}
+
+// findContentRepositoryIDByName returns the ID of the content repository with the given name, so repositories can be
+// imported by their name
+func findContentRepositoryIDByName(ctx context.Context, ci *config.ClientInfo, hubID string, name string) (string, error) {
+	repository, err := findContentRepositoryByName(ctx, ci, hubID, name)
+	return repository.ID, err
+}
@@ -1,10 +1,22 @@
 package amplience
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
 	"testing"
 
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/labd/amplience-go-sdk/content"
+	"github.com/labd/terraform-provider-amplience/internal/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -58,3 +70,173 @@ func testAccCheckContentRepositoryDestroy(state *terraform.State) error {
 	//TODO: Implement
 	return nil
 }
+
+func TestReconcileContentRepositoryContentTypes(t *testing.T) {
+	t.Parallel()
+
+	var mu sync.Mutex
+	var calls []string
+	assigned := map[string]bool{"banner": true, "manual": true}
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/content-repositories/repository-id/content-types":
+			var body struct {
+				ContentTypeID string `json:"contentTypeId"`
+			}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assigned[body.ContentTypeID] = true
+			calls = append(calls, "assign "+body.ContentTypeID)
+		case r.Method == http.MethodDelete && strings.HasPrefix(r.URL.Path, "/content-repositories/repository-id/content-types/"):
+			id := strings.TrimPrefix(r.URL.Path, "/content-repositories/repository-id/content-types/")
+			delete(assigned, id)
+			calls = append(calls, "remove "+id)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+
+		contentTypes := []map[string]string{}
+		for id := range assigned {
+			contentTypes = append(contentTypes, map[string]string{"hubContentTypeId": id})
+		}
+		testutils.WriteJSON(w, http.StatusOK, map[string]interface{}{
+			"id":           "repository-id",
+			"contentTypes": contentTypes,
+		})
+	})
+	ci := testutils.NewClientInfo(t, handler)
+
+	repository := content.ContentRepository{
+		ID: "repository-id",
+		ContentTypes: []content.ContentTypeReference{
+			{HubContentTypeID: "banner"},
+			{HubContentTypeID: "manual"},
+		},
+	}
+	desired := schema.NewSet(schema.HashString, []interface{}{"banner", "carousel"})
+
+	result, err := reconcileContentRepositoryContentTypes(ci, repository, desired)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"remove manual", "assign carousel"}, calls)
+
+	var ids []string
+	for _, contentType := range result.ContentTypes {
+		ids = append(ids, contentType.HubContentTypeID)
+	}
+	sort.Strings(ids)
+	assert.Equal(t, []string{"banner", "carousel"}, ids)
+}
+
+func TestContentRepositoryUpdateClearsContentTypes(t *testing.T) {
+	var mu sync.Mutex
+	var calls []string
+	repository := map[string]interface{}{
+		"id":           "repository-id",
+		"name":         "content",
+		"label":        "Content",
+		"contentTypes": []map[string]string{{"hubContentTypeId": "banner"}},
+	}
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/content-repositories/repository-id":
+		case r.Method == http.MethodDelete && r.URL.Path == "/content-repositories/repository-id/content-types/banner":
+			calls = append(calls, "remove banner")
+			repository["contentTypes"] = []map[string]string{}
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		testutils.WriteJSON(w, http.StatusOK, repository)
+	})
+	ci := testutils.NewClientInfo(t, handler)
+
+	r := resourceContentRepository()
+	current := r.Data(nil)
+	current.SetId("repository-id")
+	require.NoError(t, current.Set("hub_id", testutils.HubID))
+	require.NoError(t, current.Set("name", "content"))
+	require.NoError(t, current.Set("label", "Content"))
+	require.NoError(t, current.Set("content_type_ids", []interface{}{"banner"}))
+	state := current.State()
+
+	diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"hub_id": testutils.HubID,
+		"name":   "content",
+		"label":  "Content",
+	}), ci)
+	require.NoError(t, err)
+	require.NotNil(t, diff)
+	// The legacy config does not differ between an empty and an omitted set, the raw config does
+	diff.RawConfig = cty.ObjectVal(map[string]cty.Value{
+		"content_type_ids": cty.SetValEmpty(cty.String),
+	})
+
+	result, diags := r.Apply(context.Background(), state, diff, ci)
+	require.False(t, diags.HasError(), "%v", diags)
+
+	assert.Equal(t, []string{"remove banner"}, calls)
+	assert.Equal(t, "0", result.Attributes["content_type_ids.#"])
+}
+
+func TestContentTypeIDsManaged(t *testing.T) {
+	objectType := cty.Object(map[string]cty.Type{"content_type_ids": cty.Set(cty.String)})
+
+	assert.False(t, contentTypeIDsManaged(cty.NullVal(objectType)))
+	assert.False(t, contentTypeIDsManaged(cty.ObjectVal(map[string]cty.Value{
+		"content_type_ids": cty.NullVal(cty.Set(cty.String)),
+	})))
+	assert.True(t, contentTypeIDsManaged(cty.ObjectVal(map[string]cty.Value{
+		"content_type_ids": cty.SetValEmpty(cty.String),
+	})))
+}
+
+func TestContentRepositoryReadUsesHubOfRepository(t *testing.T) {
+	t.Parallel()
+	tcs := []struct {
+		Name     string
+		Links    map[string]interface{}
+		Expected string
+	}{
+		{
+			Name: "Hub of the repository",
+			Links: map[string]interface{}{
+				"hub": map[string]interface{}{"href": "https://api.amplience.net/v2/content/hubs/other-hub"},
+			},
+			Expected: "other-hub",
+		},
+		{
+			Name:     "Hub of the provider when the repository has no link",
+			Links:    map[string]interface{}{},
+			Expected: testutils.HubID,
+		},
+	}
+
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			ci := testutils.NewClientInfo(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/content-repositories/repository-id", r.URL.Path)
+				testutils.WriteJSON(w, http.StatusOK, map[string]interface{}{
+					"id":     "repository-id",
+					"name":   "content",
+					"label":  "Content",
+					"_links": tc.Links,
+				})
+			}))
+
+			data := resourceContentRepository().TestResourceData()
+			data.SetId("repository-id")
+
+			diags := resourceContentRepositoryRead(context.Background(), data, ci)
+			require.False(t, diags.HasError(), "%v", diags)
+			assert.Equal(t, tc.Expected, data.Get("hub_id"))
+		})
+	}
+}
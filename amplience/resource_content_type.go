@@ -2,10 +2,13 @@ package amplience
 
 import (
 	"context"
+	"fmt"
 	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/labd/amplience-go-sdk/content"
+	"github.com/labd/terraform-provider-amplience/internal/config"
+	"github.com/labd/terraform-provider-amplience/internal/utils"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -23,9 +26,11 @@ func resourceContentType() *schema.Resource {
 		UpdateContext: resourceContentTypeUpdate,
 		DeleteContext: resourceContentTypeDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importHubScopedResourceByKey(getContentType, findContentTypeIDByURI),
 		},
 		Schema: map[string]*schema.Schema{
+			"hub_id":         hubIDSchema(),
+			"adopt_existing": adoptExistingSchema("content type", "`content_type_uri`"),
 			"content_type_uri": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -85,41 +90,51 @@ func resourceContentTypeCreate(ctx context.Context, data *schema.ResourceData, m
 	var diags diag.Diagnostics
 	ci := getClient(meta)
 
+	hubID, err := requireHubID(data, ci)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	input := resourceContentTypeCreateInput(data)
-	instance, err := ci.Client.ContentTypeCreate(ci.HubID, input)
-
-	if errResp, ok := err.(*content.ErrorResponse); ok {
-		if errResp.StatusCode >= 400 {
-
-			log.Println("Received 400 conflict response: content type already exists.")
-			log.Println("Proceeding to unarchive if necessary and update exiting content type.")
-
-			instance, err = ci.Client.ContentTypeFindByUri(input.ContentTypeURI, ci.HubID)
+	instance, err := ci.Client.ContentTypeCreate(hubID, input)
+	if err != nil {
+		if !utils.IsAlreadyExists(err, "contentTypeUri") {
+			return apiErrorDiagnostics("Failed to create content type", err)
+		}
 
-			if err != nil {
-				return diag.FromErr(err)
-			}
+		existing, findErr := ci.API.ContentTypeFindByURI(ctx, hubID, input.ContentTypeURI)
+		if findErr != nil {
+			// The content type URI was rejected for another reason
+			return apiErrorDiagnostics("Failed to create content type", err)
+		}
 
-			if instance.Status == string(content.StatusArchived) {
-				instance, err = ci.Client.ContentTypeUnarchive(instance.ID)
-			}
-			if err != nil {
-				return diag.FromErr(err)
-			}
+		// An archived content type is what remains after a destroy, or after it was archived outside of Terraform
+		// and removed from the state on refresh. Reusing it lets Terraform recreate the content type.
+		if !utils.IsArchived(existing.Status) && !data.Get("adopt_existing").(bool) {
+			return alreadyExistsDiagnostics("content type", input.ContentTypeURI, err)
+		}
 
-			instance, err = ci.Client.ContentTypeUpdate(instance, input)
-			if err != nil {
-				return diag.FromErr(err)
-			}
+		log.Printf("[INFO] content type %s already exists, adopting it", input.ContentTypeURI)
+		instance, err = resourceContentTypeAdopt(ci, existing, input)
+		if err != nil {
+			return apiErrorDiagnostics(fmt.Sprintf("Failed to adopt existing content type %s", input.ContentTypeURI), err)
 		}
 	}
 
-	if err != nil {
-		return diag.FromErr(err)
+	resourceContentTypeSaveState(data, hubID, instance)
+	return diags
+}
+
+// resourceContentTypeAdopt unarchives the existing content type instance when necessary and updates it to match input
+func resourceContentTypeAdopt(ci *config.ClientInfo, instance content.ContentType, input content.ContentTypeInput) (content.ContentType, error) {
+	var err error
+	if utils.IsArchived(instance.Status) {
+		instance, err = ci.Client.ContentTypeUnarchive(instance.ID)
+		if err != nil {
+			return instance, err
+		}
 	}
 
-	resourceContentTypeSaveState(data, instance)
-	return diags
+	return ci.Client.ContentTypeUpdate(instance, input)
 }
 
 func resourceContentTypeRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -129,10 +144,19 @@ func resourceContentTypeRead(ctx context.Context, data *schema.ResourceData, met
 	content_type_id := data.Id()
 	content_type, err := ci.Client.ContentTypeGet(content_type_id)
 	if err != nil {
-		return diag.FromErr(err)
+		if utils.IsNotFound(err) {
+			return removeFromState(data, "content type")
+		}
+		return apiErrorDiagnostics("Failed to read content type", err)
+	}
+
+	// Content types cannot be deleted, only archived. An archived content type is what remains after a destroy, so
+	// treat it as gone. Create unarchives it when Terraform recreates it.
+	if utils.IsArchived(content_type.Status) {
+		return removeFromState(data, "content type")
 	}
 
-	resourceContentTypeSaveState(data, content_type)
+	resourceContentTypeSaveState(data, getHubID(data, ci), content_type)
 	return diags
 }
 
@@ -144,7 +168,7 @@ func resourceContentTypeUpdate(ctx context.Context, data *schema.ResourceData, m
 
 	instance, err := ci.Client.ContentTypeGet(id)
 	if err != nil {
-		return diag.FromErr(err)
+		return apiErrorDiagnostics("Failed to read content type", err)
 	}
 
 	if instance.Status == string(content.StatusArchived) {
@@ -152,17 +176,17 @@ func resourceContentTypeUpdate(ctx context.Context, data *schema.ResourceData, m
 
 		instance, err = ci.Client.ContentTypeUnarchive(instance.ID)
 		if err != nil {
-			return diag.FromErr(err)
+			return apiErrorDiagnostics("Failed to unarchive content type", err)
 		}
 	}
 
 	input := resourceContentTypeCreateInput(data)
 	content_type, err := ci.Client.ContentTypeUpdate(instance, input)
 	if err != nil {
-		return diag.FromErr(err)
+		return apiErrorDiagnostics("Failed to update content type", err)
 	}
 
-	resourceContentTypeSaveState(data, content_type)
+	resourceContentTypeSaveState(data, getHubID(data, ci), content_type)
 	return diags
 }
 
@@ -175,18 +199,20 @@ func resourceContentTypeDelete(ctx context.Context, data *schema.ResourceData, m
 
 	_, err := ci.Client.ContentTypeArchive(id)
 	if err != nil {
-		return diag.FromErr(err)
+		return apiErrorDiagnostics("Failed to archive content type", err)
 	}
 
 	data.SetId("")
 	return diags
 }
 
-func resourceContentTypeSaveState(data *schema.ResourceData, resource content.ContentType) {
+func resourceContentTypeSaveState(data *schema.ResourceData, hubID string, resource content.ContentType) {
 	icons := marshallContentTypeSettingsIcons(&resource.Settings.Icons)
 	visualizations := marshallContentTypeSettingsVisualizations(&resource.Settings.Visualizations)
 
 	data.SetId(resource.ID)
+	data.Set("hub_id", hubID)
+	data.Set("adopt_existing", data.Get("adopt_existing").(bool))
 	data.Set("content_type_uri", resource.ContentTypeURI)
 	data.Set("status", resource.Status)
 	data.Set("label", resource.Settings.Label)
@@ -286,3 +312,20 @@ func marshallContentTypeSettingsVisualizations(visualizations *[]content.Content
 	}
 	return make([]interface{}, 0)
 }
+
+// getContentType checks that the content type with the given ID can be imported. An archived content type would be
+// removed from the state on the next refresh.
+func getContentType(ci *config.ClientInfo, id string) error {
+	contentType, err := ci.Client.ContentTypeGet(id)
+	if err == nil && utils.IsArchived(contentType.Status) {
+		return fmt.Errorf("content type %s is archived. Unarchive it in Amplience before importing it", id)
+	}
+	return err
+}
+
+// findContentTypeIDByURI returns the ID of the active content type with the given URI, so content types can be
+// imported by their URI
+func findContentTypeIDByURI(ctx context.Context, ci *config.ClientInfo, hubID string, uri string) (string, error) {
+	contentType, err := ci.API.ContentTypeFindActiveByURI(ctx, hubID, uri)
+	return contentType.ID, err
+}
@@ -2,9 +2,12 @@ package amplience
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/labd/amplience-go-sdk/content"
+	"github.com/labd/terraform-provider-amplience/internal/utils"
 )
 
 func resourceContentTypeAssignment() *schema.Resource {
@@ -13,6 +16,9 @@ func resourceContentTypeAssignment() *schema.Resource {
 		CreateContext: resourceContentTypeAssignmentCreate,
 		ReadContext:   resourceContentTypeAssignmentRead,
 		DeleteContext: resourceContentTypeAssignmentDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceContentTypeAssignmentImport,
+		},
 		Schema: map[string]*schema.Schema{
 			"repository_id": {
 				Description: "ID of the Content Repository to assign the type to",
@@ -48,11 +54,23 @@ func resourceContentTypeAssignmentCreate(ctx context.Context, data *schema.Resou
 
 func resourceContentTypeAssignmentRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
+	ci := getClient(meta)
 
-	repository_id, content_type_id := parseID(data.Id())
-	// TODO: check amplience
-	resourceContentTypeAssignmentSaveState(data, repository_id, content_type_id)
+	repository_id, content_type_id := utils.ParseID(data.Id())
+
+	repository, err := ci.Client.ContentRepositoryGet(repository_id)
+	if err != nil {
+		if utils.IsNotFound(err) {
+			return removeFromState(data, "content type assignment")
+		}
+		return diag.FromErr(err)
+	}
 
+	if !repositoryHasContentType(repository, content_type_id) {
+		return removeFromState(data, "content type assignment")
+	}
+
+	resourceContentTypeAssignmentSaveState(data, repository_id, content_type_id)
 	return diags
 }
 
@@ -61,10 +79,10 @@ func resourceContentTypeAssignmentDelete(ctx context.Context, data *schema.Resou
 	var diags diag.Diagnostics
 	ci := getClient(meta)
 
-	repository_id, content_type_id := parseID(data.Id())
+	repository_id, content_type_id := utils.ParseID(data.Id())
 
 	_, err := ci.Client.ContentRepositoryRemoveContentType(repository_id, content_type_id)
-	if err != nil {
+	if err != nil && !utils.IsNotFound(err) {
 		return diag.FromErr(err)
 	}
 
@@ -73,8 +91,28 @@ func resourceContentTypeAssignmentDelete(ctx context.Context, data *schema.Resou
 
 }
 
+// resourceContentTypeAssignmentImport imports an assignment using a <repository_id>:<content_type_id> ID
+func resourceContentTypeAssignmentImport(ctx context.Context, data *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	repository_id, content_type_id := utils.ParseID(data.Id())
+	if repository_id == "" || content_type_id == "" {
+		return nil, fmt.Errorf("invalid import ID %q, expected <repository_id>:<content_type_id>", data.Id())
+	}
+
+	resourceContentTypeAssignmentSaveState(data, repository_id, content_type_id)
+	return []*schema.ResourceData{data}, nil
+}
+
 func resourceContentTypeAssignmentSaveState(data *schema.ResourceData, repository_id string, content_type_id string) {
-	data.SetId(createID(repository_id, content_type_id))
+	data.SetId(utils.CreateID(repository_id, content_type_id))
 	data.Set("repository_id", repository_id)
 	data.Set("content_type_id", content_type_id)
 }
+
+func repositoryHasContentType(repository content.ContentRepository, content_type_id string) bool {
+	for _, contentType := range repository.ContentTypes {
+		if contentType.HubContentTypeID == content_type_id {
+			return true
+		}
+	}
+	return false
+}
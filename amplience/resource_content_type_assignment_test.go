@@ -0,0 +1,88 @@
+package amplience
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/labd/terraform-provider-amplience/internal/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testContentRepositoryHandler(contentTypeIDs ...string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var contentTypes []map[string]interface{}
+		for _, id := range contentTypeIDs {
+			contentTypes = append(contentTypes, map[string]interface{}{
+				"hubContentTypeId": id,
+				"contentTypeUri":   "https://schema.example.com/" + id + ".json",
+			})
+		}
+		testutils.WriteJSON(w, http.StatusOK, map[string]interface{}{
+			"id":           "repository-id",
+			"name":         "content",
+			"label":        "Content",
+			"contentTypes": contentTypes,
+		})
+	}
+}
+
+func TestContentTypeAssignmentRead(t *testing.T) {
+	t.Parallel()
+	tcs := []struct {
+		Name    string
+		Handler http.HandlerFunc
+		ID      string
+	}{
+		{
+			Name:    "Keeps an assignment that exists",
+			Handler: testContentRepositoryHandler("banner", "content-type-id"),
+			ID:      "repository-id:content-type-id",
+		},
+		{
+			Name:    "Removes an assignment that no longer exists",
+			Handler: testContentRepositoryHandler("banner"),
+			ID:      "",
+		},
+		{
+			Name:    "Removes an assignment of a repository that no longer exists",
+			Handler: testutils.NotFound,
+			ID:      "",
+		},
+	}
+
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			ci := testutils.NewClientInfo(t, tc.Handler)
+
+			data := resourceContentTypeAssignment().TestResourceData()
+			data.SetId("repository-id:content-type-id")
+
+			diags := resourceContentTypeAssignmentRead(context.Background(), data, ci)
+			assert.False(t, diags.HasError(), "%v", diags)
+			assert.Equal(t, tc.ID, data.Id())
+		})
+	}
+}
+
+func TestContentTypeAssignmentImport(t *testing.T) {
+	t.Parallel()
+
+	data := resourceContentTypeAssignment().TestResourceData()
+	data.SetId("repository-id:content-type-id")
+
+	result, err := resourceContentTypeAssignmentImport(context.Background(), data, nil)
+	require.NoError(t, err)
+	require.Len(t, result, 1)
+	assert.Equal(t, "repository-id", result[0].Get("repository_id"))
+	assert.Equal(t, "content-type-id", result[0].Get("content_type_id"))
+
+	data = resourceContentTypeAssignment().TestResourceData()
+	data.SetId("content-type-id")
+
+	_, err = resourceContentTypeAssignmentImport(context.Background(), data, nil)
+	assert.ErrorContains(t, err, "expected <repository_id>:<content_type_id>")
+}
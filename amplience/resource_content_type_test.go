@@ -0,0 +1,184 @@
+package amplience
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/labd/terraform-provider-amplience/internal/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testContentTypeURI = "https://schema.example.com/banner.json"
+
+func testContentTypeCreateHandler(t *testing.T, createStatus int, existingStatus string, requests *[]string) http.HandlerFunc {
+	existing := map[string]interface{}{
+		"id":             "existing-id",
+		"contentTypeUri": testContentTypeURI,
+		"status":         existingStatus,
+		"settings":       map[string]interface{}{"label": "Old label"},
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		*requests = append(*requests, r.Method+" "+r.URL.Path)
+
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/hubs/test-hub/content-types":
+			// The response of the API for a content type URI that is already registered
+			testutils.WriteJSON(w, createStatus, map[string]interface{}{
+				"errors": []map[string]interface{}{
+					{
+						"level":        "ERROR",
+						"code":         "CONTENT_TYPE_URI_ALREADY_REGISTERED",
+						"entity":       "ContentType",
+						"property":     "contentTypeUri",
+						"invalidValue": testContentTypeURI,
+						"message":      "Content type already registered",
+					},
+				},
+			})
+		case r.Method == http.MethodGet && r.URL.Path == "/hubs/test-hub/content-types":
+			testutils.WriteJSON(w, http.StatusOK, map[string]interface{}{
+				"_embedded": map[string]interface{}{"content-types": []interface{}{existing}},
+				"page":      map[string]interface{}{"totalPages": 1},
+			})
+		case r.Method == http.MethodPost && r.URL.Path == "/content-types/existing-id/unarchive":
+			existing["status"] = "ACTIVE"
+			testutils.WriteJSON(w, http.StatusOK, existing)
+		case r.Method == http.MethodPatch && r.URL.Path == "/content-types/existing-id":
+			existing["settings"] = map[string]interface{}{"label": "Banner"}
+			testutils.WriteJSON(w, http.StatusOK, existing)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}
+}
+
+func TestContentTypeCreateConflict(t *testing.T) {
+	t.Parallel()
+	tcs := []struct {
+		Name           string
+		CreateStatus   int
+		ExistingStatus string
+		AdoptExisting  bool
+		Requests       []string
+		Error          string
+	}{
+		{
+			Name:           "Adopts an existing content type on conflict when enabled",
+			CreateStatus:   http.StatusConflict,
+			ExistingStatus: "ACTIVE",
+			AdoptExisting:  true,
+			Requests: []string{
+				"POST /hubs/test-hub/content-types",
+				"GET /hubs/test-hub/content-types",
+				"PATCH /content-types/existing-id",
+			},
+		},
+		{
+			Name:           "Adopts an existing content type on a bad request for the URI when enabled",
+			CreateStatus:   http.StatusBadRequest,
+			ExistingStatus: "ACTIVE",
+			AdoptExisting:  true,
+			Requests: []string{
+				"POST /hubs/test-hub/content-types",
+				"GET /hubs/test-hub/content-types",
+				"PATCH /content-types/existing-id",
+			},
+		},
+		{
+			Name:           "Does not adopt an active content type when disabled",
+			CreateStatus:   http.StatusBadRequest,
+			ExistingStatus: "ACTIVE",
+			AdoptExisting:  false,
+			Requests: []string{
+				"POST /hubs/test-hub/content-types",
+				"GET /hubs/test-hub/content-types",
+			},
+			Error: "already exists",
+		},
+		{
+			Name:           "Unarchives an archived content type when disabled",
+			CreateStatus:   http.StatusBadRequest,
+			ExistingStatus: "ARCHIVED",
+			AdoptExisting:  false,
+			Requests: []string{
+				"POST /hubs/test-hub/content-types",
+				"GET /hubs/test-hub/content-types",
+				"POST /content-types/existing-id/unarchive",
+				"PATCH /content-types/existing-id",
+			},
+		},
+		{
+			Name:           "Does not adopt on other errors",
+			CreateStatus:   http.StatusForbidden,
+			ExistingStatus: "ACTIVE",
+			AdoptExisting:  true,
+			Requests:       []string{"POST /hubs/test-hub/content-types"},
+			Error:          "Failed to create content type",
+		},
+	}
+
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			var requests []string
+			ci := testutils.NewClientInfo(t, testContentTypeCreateHandler(t, tc.CreateStatus, tc.ExistingStatus, &requests))
+
+			data := resourceContentType().TestResourceData()
+			require.NoError(t, data.Set("content_type_uri", testContentTypeURI))
+			require.NoError(t, data.Set("label", "Banner"))
+			require.NoError(t, data.Set("status", "ACTIVE"))
+			require.NoError(t, data.Set("adopt_existing", tc.AdoptExisting))
+
+			diags := resourceContentTypeCreate(context.Background(), data, ci)
+			assert.Equal(t, tc.Requests, requests)
+
+			if tc.Error == "" {
+				require.False(t, diags.HasError(), "%v", diags)
+				assert.Equal(t, "existing-id", data.Id())
+				assert.Equal(t, "Banner", data.Get("label"))
+				return
+			}
+
+			require.True(t, diags.HasError())
+			assert.Contains(t, diags[0].Summary, tc.Error)
+			assert.Contains(t, diags[0].Detail, "Content type already registered")
+			assert.Contains(t, diags[0].Detail, "property: contentTypeUri")
+			assert.Empty(t, data.Id())
+		})
+	}
+}
+
+func TestContentTypeCreateValidationError(t *testing.T) {
+	t.Parallel()
+	var requests []string
+	ci := testutils.NewClientInfo(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		testutils.WriteJSON(w, http.StatusBadRequest, map[string]interface{}{
+			"errors": []map[string]interface{}{
+				{
+					"level":        "ERROR",
+					"entity":       "ContentType",
+					"property":     "contentTypeUri",
+					"invalidValue": "banner",
+					"message":      "must be a valid URI",
+				},
+			},
+		})
+	}))
+
+	data := resourceContentType().TestResourceData()
+	require.NoError(t, data.Set("content_type_uri", "banner"))
+	require.NoError(t, data.Set("label", "Banner"))
+	require.NoError(t, data.Set("adopt_existing", true))
+
+	diags := resourceContentTypeCreate(context.Background(), data, ci)
+	assert.Equal(t, []string{"POST /hubs/test-hub/content-types"}, requests)
+	require.True(t, diags.HasError())
+	assert.Equal(t, "Failed to create content type", diags[0].Summary)
+	assert.Contains(t, diags[0].Detail, "must be a valid URI (entity: ContentType, property: contentTypeUri")
+	assert.Empty(t, data.Id())
+}
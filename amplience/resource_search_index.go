@@ -7,7 +7,12 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/labd/amplience-go-sdk/content"
+	"github.com/labd/terraform-provider-amplience/internal/api"
+	"github.com/labd/terraform-provider-amplience/internal/config"
+	"github.com/labd/terraform-provider-amplience/internal/utils"
 )
 
 func resourceSearchIndex() *schema.Resource {
@@ -19,23 +24,27 @@ func resourceSearchIndex() *schema.Resource {
 		UpdateContext: resourceSearchIndexUpdate,
 		DeleteContext: resourceSearchIndexDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceSearchIndexImport,
 		},
 		Schema: map[string]*schema.Schema{
+			"hub_id": hubIDSchema(),
 			"label": {
 				Description: "Label for the Index",
 				Type:        schema.TypeString,
 				Required:    true,
 			},
 			"suffix": {
-				Description: "Suffix for the Index",
-				Type:        schema.TypeString,
-				Required:    true,
+				Description: "Suffix for the Index. Changing the suffix replaces the index, which requires a republish " +
+					"of the content of the assigned content types",
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
 			},
 			"type": {
-				Description: "Either PRODUCTION or STAGING",
+				Description: "Either PRODUCTION or STAGING. Changing the type replaces the index",
 				Type:        schema.TypeString,
 				Required:    true,
+				ForceNew:    true,
 			},
 			"content_types": {
 				Description: "List of content type urls. Each content type will create 2 corresponding webhooks (PUT & DELETE)",
@@ -45,15 +54,20 @@ func resourceSearchIndex() *schema.Resource {
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
 			"settings": {
-				Type:        schema.TypeString,
-				Description: "A JSON string containing Algolia settings (https://www.algolia.com/doc/api-reference/api-parameters/)",
-				Optional:    true,
+				Type: schema.TypeString,
+				Description: "A JSON string containing Algolia settings (https://www.algolia.com/doc/api-reference/api-parameters/). " +
+					"Only the settings in this JSON are managed and compared with the settings of the index. " +
+					"After an import all settings of the index are read",
+				Optional:         true,
+				ValidateDiagFunc: ValidateDiagWrapper(validation.StringIsJSON),
+				DiffSuppressFunc: structure.SuppressJsonDiff,
 			},
 			"webhook_custom_payload": {
-				Type:        schema.TypeMap,
-				Description: "A Handlebars Json string for the custom payload that will be used for each content type webhook",
-				Optional:    true,
-				Elem:        &schema.Schema{Type: schema.TypeString},
+				Type:             schema.TypeMap,
+				Description:      "A Handlebars Json string for the custom payload that will be used for each content type webhook",
+				Optional:         true,
+				Elem:             &schema.Schema{Type: schema.TypeString},
+				ValidateDiagFunc: ValidateCustomPayloadMap,
 			},
 		},
 	}
@@ -68,19 +82,25 @@ func resourceSearchIndexCreate(ctx context.Context, data *schema.ResourceData, m
 		return diag.FromErr(err)
 	}
 
-	resource, err := ci.Client.AlgoliaIndexCreate(ci.HubID, *input)
+	hubID, err := requireHubID(data, ci)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	resource, err := ci.Client.AlgoliaIndexCreate(hubID, *input)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	err = updateIndexWebhooksAndSettings(ci.Client, ci.HubID, resource.ID, data)
+	err = updateIndexWebhooksAndSettings(ctx, ci, hubID, resource.ID, data)
 	if err != nil {
 		// clean up for timeouts etc.
-		_, err = ci.Client.AlgoliaIndexDelete(ci.HubID, resource.ID)
+		if _, deleteErr := ci.Client.AlgoliaIndexDelete(hubID, resource.ID); deleteErr != nil {
+			return diag.FromErr(fmt.Errorf("%w (the index %s could not be removed: %s)", err, resource.ID, deleteErr))
+		}
 		return diag.FromErr(err)
 	}
 
-	resourceSearchIndexSaveState(data, resource)
+	resourceSearchIndexSaveState(data, hubID, resource)
 	return diags
 }
 
@@ -88,23 +108,64 @@ func resourceSearchIndexRead(ctx context.Context, data *schema.ResourceData, met
 	var diags diag.Diagnostics
 	ci := getClient(meta)
 
+	hubID := getHubID(data, ci)
 	id := data.Id()
 
-	resource, err := ci.Client.AlgoliaIndexGet(ci.HubID, id)
+	resource, err := ci.Client.AlgoliaIndexGet(hubID, id)
+	if err != nil {
+		if utils.IsNotFound(err) {
+			return removeFromState(data, "search index")
+		}
+		return diag.FromErr(err)
+	}
+
+	settings, err := readSearchIndexSettings(ctx, ci, hubID, id, data.Get("settings").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	assigned, webhooks, err := readSearchIndexWebhooks(ctx, ci, hubID, id)
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	resourceSearchIndexSaveState(data, resource)
+
+	resourceSearchIndexSaveState(data, hubID, resource)
+	data.Set("settings", settings)
+	data.Set("content_types", orderLike(assigned, toStringSlice(data.Get("content_types").([]interface{}))))
+	data.Set("webhook_custom_payload", searchIndexCustomPayload(webhooks, data.Get("webhook_custom_payload")))
 	return diags
 }
 
+// resourceSearchIndexImport reads all settings of the index into the state, since there is no configuration yet to
+// select the managed settings with. The next refresh only keeps the settings that are in the configuration.
+func resourceSearchIndexImport(ctx context.Context, data *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	result, err := importHubScopedResource(ctx, data, meta)
+	if err != nil {
+		return nil, err
+	}
+
+	settings, err := getClient(meta).API.AlgoliaIndexSettingsGet(ctx, data.Get("hub_id").(string), data.Id())
+	if err != nil {
+		return nil, err
+	}
+	value, err := json.Marshal(settings)
+	if err != nil {
+		return nil, err
+	}
+	if err := data.Set("settings", string(value)); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 func resourceSearchIndexUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	ci := getClient(meta)
 
+	hubID := getHubID(data, ci)
 	id := data.Id()
 
-	old, err := ci.Client.AlgoliaIndexGet(ci.HubID, id)
+	current, err := ci.Client.AlgoliaIndexGet(hubID, id)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -114,32 +175,18 @@ func resourceSearchIndexUpdate(ctx context.Context, data *schema.ResourceData, m
 		return diag.FromErr(err)
 	}
 
-	// Amplience can actually only update the Label, so we delete the old index and create a new one.
-	// NOTE: this removes all currently saved indexes and requires a republish of all content types involved.
-	var new content.AlgoliaIndex
-	if old.Suffix != input.Suffix || old.Type != input.Type {
-		_, err = ci.Client.AlgoliaIndexDelete(ci.HubID, id)
-		if err != nil {
-			return diag.FromErr(err)
-		}
-		new, err = ci.Client.AlgoliaIndexCreate(ci.HubID, *input)
-		if err != nil {
-			return diag.FromErr(err)
-		}
-
-	} else {
-		new, err = ci.Client.AlgoliaIndexUpdate(ci.HubID, old, *input)
-		if err != nil {
-			return diag.FromErr(err)
-		}
+	// The suffix and type can not be updated, a change of either replaces the index
+	updated, err := ci.Client.AlgoliaIndexUpdate(hubID, current, *input)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
-	err = updateIndexWebhooksAndSettings(ci.Client, ci.HubID, id, data)
+	err = updateIndexWebhooksAndSettings(ctx, ci, hubID, updated.ID, data)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	resourceSearchIndexSaveState(data, new)
+	resourceSearchIndexSaveState(data, hubID, updated)
 	return diags
 }
 
@@ -149,7 +196,7 @@ func resourceSearchIndexDelete(ctx context.Context, data *schema.ResourceData, m
 
 	id := data.Id()
 
-	_, err := ci.Client.AlgoliaIndexDelete(ci.HubID, id)
+	_, err := ci.Client.AlgoliaIndexDelete(getHubID(data, ci), id)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -158,8 +205,9 @@ func resourceSearchIndexDelete(ctx context.Context, data *schema.ResourceData, m
 	return diags
 }
 
-func resourceSearchIndexSaveState(data *schema.ResourceData, resource content.AlgoliaIndex) {
+func resourceSearchIndexSaveState(data *schema.ResourceData, hubID string, resource content.AlgoliaIndex) {
 	data.SetId(resource.ID)
+	data.Set("hub_id", hubID)
 	data.Set("label", resource.Label)
 	data.Set("suffix", resource.Suffix)
 	data.Set("type", resource.Type)
@@ -189,21 +237,13 @@ func createIndexInput(data *schema.ResourceData) (*content.AlgoliaIndexInput, er
 	return input, nil
 }
 
-func createAlgoliaIndexSettings(input string) (content.AlgoliaIndexSettings, error) {
-	settingsInput := content.AlgoliaIndexSettings{}
-	err := json.Unmarshal([]byte(input), &settingsInput)
-	return settingsInput, err
-}
-func updateIndexWebhooksAndSettings(client *content.Client, hubId string, indexId string, data *schema.ResourceData) error {
-
-	settingsInput, err := createAlgoliaIndexSettings(data.Get("settings").(string))
-	if err != nil {
-		return err
-	}
-
-	_, err = client.AlgoliaIndexSettingsUpdate(hubId, indexId, settingsInput)
-	if err != nil {
-		return err
+func updateIndexWebhooksAndSettings(ctx context.Context, ci *config.ClientInfo, hubId string, indexId string, data *schema.ResourceData) error {
+	// The settings are sent as is, the settings struct of the SDK only supports part of the Algolia settings
+	if settings := data.Get("settings").(string); settings != "" {
+		err := ci.API.AlgoliaIndexSettingsUpdate(ctx, hubId, indexId, json.RawMessage(settings))
+		if err != nil {
+			return err
+		}
 	}
 
 	customPayload, err := resourceWebhookGetCustomPayloadAndValidate(data.Get("webhook_custom_payload"))
@@ -211,13 +251,13 @@ func updateIndexWebhooksAndSettings(client *content.Client, hubId string, indexI
 		return err
 	}
 
-	webhooks, err := client.AlgoliaIndexWebhooksGet(hubId, indexId)
+	_, webhooks, err := readSearchIndexWebhooks(ctx, ci, hubId, indexId)
 	if err != nil {
 		return err
 	}
 
 	for _, item := range webhooks {
-		_, err = client.WebhookUpdate(hubId, item, content.WebhookInput{
+		_, err = ci.Client.WebhookUpdate(hubId, item, content.WebhookInput{
 			CustomPayload: customPayload,
 			Label:         item.Label,
 			Events:        item.Events,
@@ -235,3 +275,84 @@ func updateIndexWebhooksAndSettings(client *content.Client, hubId string, indexI
 
 	return err
 }
+
+// readSearchIndexSettings returns the settings of the index that are set in current, the settings in the state. The
+// index has a value for every Algolia setting, so only the managed settings are compared. When the settings are
+// equal to current, current is returned to keep its formatting.
+func readSearchIndexSettings(ctx context.Context, ci *config.ClientInfo, hubID string, indexID string, current string) (string, error) {
+	if current == "" {
+		return "", nil
+	}
+
+	remote, err := ci.API.AlgoliaIndexSettingsGet(ctx, hubID, indexID)
+	if err != nil {
+		return "", err
+	}
+
+	result, err := utils.ManagedSettings(current, remote)
+	if err != nil {
+		// Invalid JSON in the state, replace it with the settings that are set in the config on the next apply
+		return "", nil
+	}
+	if structure.SuppressJsonDiff("settings", current, result, nil) {
+		return current, nil
+	}
+	return result, nil
+}
+
+// readSearchIndexWebhooks returns the content types assigned to the index and the webhooks Amplience created for them
+func readSearchIndexWebhooks(ctx context.Context, ci *config.ClientInfo, hubID string, indexID string) ([]string, []content.Webhook, error) {
+	assigned, err := ci.API.AlgoliaIndexAssignedContentTypes(ctx, hubID, indexID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	contentTypes := make([]string, 0, len(assigned))
+	var webhooks []content.Webhook
+	for _, item := range assigned {
+		contentTypes = append(contentTypes, item.ContentTypeUri)
+
+		id := api.WebhookID(item)
+		if id == "" {
+			continue
+		}
+		webhook, err := ci.API.WebhookGet(ctx, hubID, id)
+		if err != nil {
+			return nil, nil, err
+		}
+		webhooks = append(webhooks, webhook)
+	}
+	return contentTypes, webhooks, nil
+}
+
+// searchIndexCustomPayload returns the custom payload of the webhooks of the index. All webhooks should have the same
+// payload; when one differs from current, the payload in the state, that payload is returned so the drift is shown.
+func searchIndexCustomPayload(webhooks []content.Webhook, current interface{}) map[string]interface{} {
+	if len(webhooks) == 0 {
+		return current.(map[string]interface{})
+	}
+
+	currentPayload, _ := resourceWebhookGetCustomPayloadAndValidate(current)
+	result := webhooks[0].CustomPayload
+	for _, webhook := range webhooks {
+		if !customPayloadEqual(webhook.CustomPayload, currentPayload) {
+			result = webhook.CustomPayload
+			break
+		}
+	}
+
+	if result == nil {
+		return nil
+	}
+	return map[string]interface{}{
+		"type":  result.Type,
+		"value": result.Value,
+	}
+}
+
+func customPayloadEqual(a *content.WebhookCustomPayload, b *content.WebhookCustomPayload) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
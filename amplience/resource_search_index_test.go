@@ -0,0 +1,198 @@
+package amplience
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/labd/terraform-provider-amplience/internal/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSearchIndexReplacedOnSuffixOrTypeChange(t *testing.T) {
+	s := resourceSearchIndex().Schema
+	assert.True(t, s["suffix"].ForceNew)
+	assert.True(t, s["type"].ForceNew)
+	assert.False(t, s["label"].ForceNew)
+}
+
+func TestSearchIndexUpdate(t *testing.T) {
+	index := map[string]interface{}{
+		"id":     "index-id",
+		"label":  "Products",
+		"suffix": "products",
+		"type":   "PRODUCTION",
+	}
+
+	var requests []string
+	ci := testutils.NewClientInfo(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+
+		switch r.URL.Path {
+		case "/algolia-search/test-hub/indexes/index-id":
+			if r.Method == http.MethodPatch {
+				index["label"] = "All products"
+			}
+			testutils.WriteJSON(w, http.StatusOK, index)
+		case "/algolia-search/test-hub/indexes/index-id/settings":
+			testutils.WriteJSON(w, http.StatusOK, map[string]interface{}{})
+		case "/algolia-search/test-hub/indexes/index-id/assigned-content-types":
+			testutils.WriteJSON(w, http.StatusOK, map[string]interface{}{
+				"_embedded": map[string]interface{}{"assigned-content-types": []interface{}{}},
+			})
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+
+	data := schema.TestResourceDataRaw(t, resourceSearchIndex().Schema, map[string]interface{}{
+		"label":         "All products",
+		"suffix":        "products",
+		"type":          "PRODUCTION",
+		"content_types": []interface{}{"https://schema.example.com/product.json"},
+		"settings":      `{"hitsPerPage": 20}`,
+	})
+	data.SetId("index-id")
+
+	diags := resourceSearchIndexUpdate(context.Background(), data, ci)
+	require.False(t, diags.HasError(), "%v", diags)
+
+	assert.Equal(t, []string{
+		"GET /algolia-search/test-hub/indexes/index-id",
+		"PATCH /algolia-search/test-hub/indexes/index-id",
+		"PATCH /algolia-search/test-hub/indexes/index-id/settings",
+		"GET /algolia-search/test-hub/indexes/index-id/assigned-content-types",
+	}, requests)
+	assert.Equal(t, "index-id", data.Id())
+	assert.Equal(t, "All products", data.Get("label"))
+}
+
+func TestSearchIndexReadDetectsDrift(t *testing.T) {
+	var server string
+	ci := testutils.NewClientInfo(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/algolia-search/test-hub/indexes/index-id":
+			testutils.WriteJSON(w, http.StatusOK, map[string]interface{}{
+				"id":     "index-id",
+				"label":  "Products",
+				"suffix": "products",
+				"type":   "PRODUCTION",
+			})
+		case "/algolia-search/test-hub/indexes/index-id/settings":
+			testutils.WriteJSON(w, http.StatusOK, map[string]interface{}{
+				"hitsPerPage":          50,
+				"searchableAttributes": []string{"title", "description"},
+				"maxValuesPerFacet":    100,
+			})
+		case "/algolia-search/test-hub/indexes/index-id/assigned-content-types":
+			server = "http://" + r.Host
+			testutils.WriteJSON(w, http.StatusOK, map[string]interface{}{
+				"_embedded": map[string]interface{}{
+					"assigned-content-types": []interface{}{
+						map[string]interface{}{
+							"contentTypeUri": "https://schema.example.com/category.json",
+							"_links":         map[string]interface{}{"webhook": map[string]interface{}{"href": server + "/hubs/test-hub/webhooks/webhook-2"}},
+						},
+						map[string]interface{}{
+							"contentTypeUri": "https://schema.example.com/product.json",
+							"_links":         map[string]interface{}{"webhook": map[string]interface{}{"href": server + "/hubs/test-hub/webhooks/webhook-1"}},
+						},
+					},
+				},
+			})
+		case "/hubs/test-hub/webhooks/webhook-1":
+			testutils.WriteJSON(w, http.StatusOK, map[string]interface{}{
+				"id":            "webhook-1",
+				"customPayload": map[string]interface{}{"type": "text/x-handlebars-template", "value": "{{payload.id}}"},
+			})
+		case "/hubs/test-hub/webhooks/webhook-2":
+			testutils.WriteJSON(w, http.StatusOK, map[string]interface{}{
+				"id":            "webhook-2",
+				"customPayload": map[string]interface{}{"type": "text/x-handlebars-template", "value": "{{payload.label}}"},
+			})
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+
+	data := schema.TestResourceDataRaw(t, resourceSearchIndex().Schema, map[string]interface{}{
+		"label":         "Products",
+		"suffix":        "products",
+		"type":          "PRODUCTION",
+		"content_types": []interface{}{"https://schema.example.com/product.json"},
+		"settings":      "{\n  \"searchableAttributes\": [\"title\", \"description\"],\n  \"hitsPerPage\": 20\n}",
+		"webhook_custom_payload": map[string]interface{}{
+			"type":  "text/x-handlebars-template",
+			"value": "{{payload.id}}",
+		},
+	})
+	data.SetId("index-id")
+
+	diags := resourceSearchIndexRead(context.Background(), data, ci)
+	require.False(t, diags.HasError(), "%v", diags)
+
+	assert.Equal(t, `{"hitsPerPage":50,"searchableAttributes":["title","description"]}`, data.Get("settings"))
+	assert.Equal(t, []interface{}{
+		"https://schema.example.com/product.json",
+		"https://schema.example.com/category.json",
+	}, data.Get("content_types"))
+	assert.Equal(t, map[string]interface{}{
+		"type":  "text/x-handlebars-template",
+		"value": "{{payload.label}}",
+	}, data.Get("webhook_custom_payload"))
+}
+
+func TestSearchIndexReadKeepsSettingsFormatting(t *testing.T) {
+	settings := "{\n  \"hitsPerPage\": 20\n}"
+	ci := testutils.NewClientInfo(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/algolia-search/test-hub/indexes/index-id":
+			testutils.WriteJSON(w, http.StatusOK, map[string]interface{}{"id": "index-id"})
+		case "/algolia-search/test-hub/indexes/index-id/settings":
+			testutils.WriteJSON(w, http.StatusOK, map[string]interface{}{"hitsPerPage": 20, "maxValuesPerFacet": 100})
+		case "/algolia-search/test-hub/indexes/index-id/assigned-content-types":
+			testutils.WriteJSON(w, http.StatusOK, map[string]interface{}{})
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+
+	data := schema.TestResourceDataRaw(t, resourceSearchIndex().Schema, map[string]interface{}{
+		"settings": settings,
+	})
+	data.SetId("index-id")
+
+	diags := resourceSearchIndexRead(context.Background(), data, ci)
+	require.False(t, diags.HasError(), "%v", diags)
+	assert.Equal(t, settings, data.Get("settings"))
+}
+
+func TestSearchIndexImportReadsSettings(t *testing.T) {
+	ci := testutils.NewClientInfo(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/algolia-search/other-hub/indexes/index-id":
+			testutils.WriteJSON(w, http.StatusOK, map[string]interface{}{"id": "index-id"})
+		case "/algolia-search/other-hub/indexes/index-id/settings":
+			testutils.WriteJSON(w, http.StatusOK, map[string]interface{}{"hitsPerPage": 20, "maxValuesPerFacet": 100})
+		case "/algolia-search/other-hub/indexes/index-id/assigned-content-types":
+			testutils.WriteJSON(w, http.StatusOK, map[string]interface{}{})
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+
+	data := schema.TestResourceDataRaw(t, resourceSearchIndex().Schema, map[string]interface{}{})
+	data.SetId("other-hub:index-id")
+
+	result, err := resourceSearchIndexImport(context.Background(), data, ci)
+	require.NoError(t, err)
+	require.Len(t, result, 1)
+
+	diags := resourceSearchIndexRead(context.Background(), data, ci)
+	require.False(t, diags.HasError(), "%v", diags)
+	assert.Equal(t, "index-id", data.Id())
+	assert.Equal(t, "other-hub", data.Get("hub_id"))
+	assert.JSONEq(t, `{"hitsPerPage": 20, "maxValuesPerFacet": 100}`, data.Get("settings").(string))
+}
@@ -9,8 +9,26 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/labd/amplience-go-sdk/content"
+	"github.com/labd/terraform-provider-amplience/internal/utils"
 )
 
+const (
+	webhookFilterIn    = "in"
+	webhookFilterEqual = "equal"
+)
+
+// webhookEvents are the events a webhook can be registered for
+var webhookEvents = []string{
+	string(content.WebhookContentItemAssigned),
+	string(content.WebhookContentItemCreated),
+	string(content.WebhookContentItemUpdated),
+	string(content.WebhookContentItemWorkflowUpdated),
+	string(content.WebhookEditionPublished),
+	string(content.WebhookEditionScheduled),
+	string(content.WebhookEditionUnscheduled),
+	string(content.WebhookSnapshotPublished),
+}
+
 func resourceWebhook() *schema.Resource {
 	return &schema.Resource{
 		Description: "A webhook is a way for Dynamic Content to automatically send messages or data to a third party " +
@@ -23,141 +41,205 @@ func resourceWebhook() *schema.Resource {
 		UpdateContext: resourceWebhookUpdate,
 		DeleteContext: resourceWebhookDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importHubScopedResource,
 		},
-		Schema: map[string]*schema.Schema{
-			"label": {
-				Description: "Label for the Webhook",
-				Type:        schema.TypeString,
-				Required:    true,
-			},
-			"events": {
-				Description: "List of events to register the Webhook against",
-				Type:        schema.TypeList,
-				Optional:    true,
-				MinItems:    1,
-				Elem:        &schema.Schema{Type: schema.TypeString},
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Version: 0,
+				Type:    resourceWebhookV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: resourceWebhookStateUpgradeV0,
 			},
-			"handlers": {
-				Description: "List of URLs to receive the Webhook",
-				Type:        schema.TypeList,
-				Optional:    true,
-				MinItems:    1,
-				Elem:        &schema.Schema{Type: schema.TypeString},
-			},
-			"active": {
-				Description: "Indicates if the Webhook should be fired",
-				Type:        schema.TypeBool,
-				Optional:    true,
+		},
+		Schema: resourceWebhookSchema(),
+	}
+}
+
+func resourceWebhookSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"hub_id": hubIDSchema(),
+		"label": {
+			Description: "Label for the Webhook",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"events": {
+			Description: "List of events to register the Webhook against",
+			Type:        schema.TypeList,
+			Optional:    true,
+			MinItems:    1,
+			Elem: &schema.Schema{
+				Type:             schema.TypeString,
+				ValidateDiagFunc: ValidateDiagWrapper(validation.StringInSlice(webhookEvents, false)),
 			},
-			// notifications is defined as an Array of objects in the API docs though it doesn't allow for more than
-			// 1 element, throwing a "Cannot exceed the maximum of 1 notification" error if you add more so setting max
-			// elements to 1
-			"notifications": {
-				Description: "List of notifications",
-				Type:        schema.TypeList,
-				Optional:    true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"email": {
-							Description: "email address to notify",
-							Type:        schema.TypeString,
-							Required:    true,
-							// TODO: Add email validation func ValidateDiagFunc:
-						},
+		},
+		"handlers": {
+			Description: "List of URLs to receive the Webhook",
+			Type:        schema.TypeList,
+			Optional:    true,
+			MinItems:    1,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"active": {
+			Description: "Indicates if the Webhook should be fired",
+			Type:        schema.TypeBool,
+			Optional:    true,
+		},
+		// notifications is defined as an Array of objects in the API docs though it doesn't allow for more than
+		// 1 element, throwing a "Cannot exceed the maximum of 1 notification" error if you add more so setting max
+		// elements to 1
+		"notifications": {
+			Description: "List of notifications",
+			Type:        schema.TypeList,
+			Optional:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"email": {
+						Description: "email address to notify",
+						Type:        schema.TypeString,
+						Required:    true,
+						// TODO: Add email validation func ValidateDiagFunc:
 					},
 				},
-				MinItems: 0,
-				MaxItems: 1,
 			},
-			"secret": {
-				Description: "Shared secret between the handler and DC",
-				Type:        schema.TypeString,
-				Optional:    true,
-				Sensitive:   true,
-			},
-			"header": {
-				Description: "List of additional headers",
-				Type:        schema.TypeList,
-				Optional:    true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"key": {
-							Description: "Header key",
-							Type:        schema.TypeString,
-							Required:    true,
-						},
-						"value": {
-							Description: "Header value",
-							Type:        schema.TypeString,
-							Optional:    true,
-						},
-						"secret_value": {
-							Description: "Indicates whether this header value is sensitive",
-							Type:        schema.TypeString,
-							Optional:    true,
-							Sensitive:   true,
-						},
+			MinItems: 0,
+			MaxItems: 1,
+		},
+		"secret": {
+			Description: "Shared secret between the handler and DC",
+			Type:        schema.TypeString,
+			Optional:    true,
+			Sensitive:   true,
+		},
+		"header": {
+			Description: "List of additional headers",
+			Type:        schema.TypeList,
+			Optional:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"key": {
+						Description: "Header key",
+						Type:        schema.TypeString,
+						Required:    true,
+					},
+					"value": {
+						Description: "Header value",
+						Type:        schema.TypeString,
+						Optional:    true,
+					},
+					"secret_value": {
+						Description: "Indicates whether this header value is sensitive",
+						Type:        schema.TypeString,
+						Optional:    true,
+						Sensitive:   true,
 					},
 				},
 			},
-			"filter": {
-				Type:     schema.TypeList,
-				Optional: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"type": {
-							Description: "Specify whether the filter is an \"in\" or an \"equal\" filter",
-							Type:        schema.TypeString,
-							Required:    true,
-						},
-						"arguments": {
-							Type:     schema.TypeList,
-							Required: true,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"json_path": {
-										Description: "JSON Path of the filed you wish to match",
-										Type:        schema.TypeString,
-										Required:    true,
-									},
-									"value": {
-										Description: "The value to compare too",
-										Type:        schema.TypeList,
-										Required:    true,
-										MinItems:    1,
-										Elem:        &schema.Schema{Type: schema.TypeString},
-									},
+		},
+		"filter": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"type": {
+						Description: "Specify whether the filter is an \"in\" or an \"equal\" filter",
+						Type:        schema.TypeString,
+						Required:    true,
+						ValidateDiagFunc: ValidateDiagWrapper(validation.StringInSlice([]string{
+							webhookFilterIn,
+							webhookFilterEqual,
+						}, false)),
+					},
+					"arguments": {
+						Type:     schema.TypeList,
+						Required: true,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"json_path": {
+									Description: "JSON Path of the filed you wish to match",
+									Type:        schema.TypeString,
+									Required:    true,
+								},
+								"value": {
+									Description: "The value to compare too",
+									Type:        schema.TypeList,
+									Required:    true,
+									MinItems:    1,
+									Elem:        &schema.Schema{Type: schema.TypeString},
 								},
 							},
-							MinItems: 0,
-							MaxItems: 1,
 						},
+						MinItems: 0,
+						MaxItems: 1,
 					},
 				},
-				MinItems: 0,
-				MaxItems: 10,
-			},
-			"method": {
-				Description: "Webhook HTTP method: POST, PATCH, PUT or DELETE",
-				Type:        schema.TypeString,
-				Required:    true,
-				ValidateDiagFunc: ValidateDiagWrapper(validation.StringInSlice([]string{
-					http.MethodDelete,
-					http.MethodPatch,
-					http.MethodPost,
-					http.MethodPut,
-				}, false)),
 			},
-			"custom_payload": {
-				Type:     schema.TypeMap,
-				Optional: true,
-				Elem:     &schema.Schema{Type: schema.TypeString},
+			MinItems: 0,
+			MaxItems: 10,
+		},
+		"method": {
+			Description: "Webhook HTTP method: POST, PATCH, PUT or DELETE",
+			Type:        schema.TypeString,
+			Required:    true,
+			ValidateDiagFunc: ValidateDiagWrapper(validation.StringInSlice([]string{
+				http.MethodDelete,
+				http.MethodPatch,
+				http.MethodPost,
+				http.MethodPut,
+			}, false)),
+		},
+		"custom_payload": {
+			Description: "Custom payload to send instead of the default payload of the event",
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"type": {
+						Description: "Type of the payload template, for example `text/x-handlebars-template`",
+						Type:        schema.TypeString,
+						Required:    true,
+					},
+					"value": {
+						Description:      "The Handlebars template of the payload, which is checked for syntax errors during plan",
+						Type:             schema.TypeString,
+						Required:         true,
+						ValidateDiagFunc: ValidateHandlebarsTemplate,
+					},
+				},
 			},
 		},
 	}
 }
 
+// resourceWebhookV0 is version 0 of the webhook resource, in which custom_payload was a map
+func resourceWebhookV0() *schema.Resource {
+	s := resourceWebhookSchema()
+	s["custom_payload"] = &schema.Schema{
+		Type:     schema.TypeMap,
+		Optional: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+	}
+	return &schema.Resource{Schema: s}
+}
+
+// resourceWebhookStateUpgradeV0 converts the custom_payload map to a custom_payload block
+func resourceWebhookStateUpgradeV0(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	payload, _ := rawState["custom_payload"].(map[string]interface{})
+	if len(payload) == 0 {
+		rawState["custom_payload"] = []interface{}{}
+		return rawState, nil
+	}
+
+	rawState["custom_payload"] = []interface{}{
+		map[string]interface{}{
+			"type":  payload["type"],
+			"value": payload["value"],
+		},
+	}
+	return rawState, nil
+}
+
 func resourceWebhookCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	ci := getClient(meta)
@@ -167,12 +249,16 @@ func resourceWebhookCreate(ctx context.Context, data *schema.ResourceData, meta
 		return diag.FromErr(fmt.Errorf("error creating webhook draft: %w", err))
 	}
 
-	webhook, err := ci.Client.WebhookCreate(ci.HubID, *input)
+	hubID, err := requireHubID(data, ci)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	webhook, err := ci.Client.WebhookCreate(hubID, *input)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	resourceWebhookSaveState(data, webhook)
+	resourceWebhookSaveState(data, hubID, webhook)
 	return diags
 }
 
@@ -180,13 +266,17 @@ func resourceWebhookRead(ctx context.Context, data *schema.ResourceData, meta in
 	var diags diag.Diagnostics
 	ci := getClient(meta)
 
+	hubID := getHubID(data, ci)
 	webhook_id := data.Id()
 
-	webhook, err := ci.Client.WebhookGet(ci.HubID, webhook_id)
+	webhook, err := ci.Client.WebhookGet(hubID, webhook_id)
 	if err != nil {
+		if utils.IsNotFound(err) {
+			return removeFromState(data, "webhook")
+		}
 		return diag.FromErr(err)
 	}
-	resourceWebhookSaveState(data, webhook)
+	resourceWebhookSaveState(data, hubID, webhook)
 	return diags
 }
 
@@ -194,9 +284,10 @@ func resourceWebhookUpdate(ctx context.Context, data *schema.ResourceData, meta
 	var diags diag.Diagnostics
 	ci := getClient(meta)
 
+	hubID := getHubID(data, ci)
 	webhook_id := data.Id()
 
-	webhook, err := ci.Client.WebhookGet(ci.HubID, webhook_id)
+	webhook, err := ci.Client.WebhookGet(hubID, webhook_id)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -206,11 +297,11 @@ func resourceWebhookUpdate(ctx context.Context, data *schema.ResourceData, meta
 		return diag.FromErr(err)
 	}
 
-	new, err := ci.Client.WebhookUpdate(ci.HubID, webhook, *input)
+	new, err := ci.Client.WebhookUpdate(hubID, webhook, *input)
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	resourceWebhookSaveState(data, new)
+	resourceWebhookSaveState(data, hubID, new)
 	return diags
 }
 
@@ -220,7 +311,7 @@ func resourceWebhookDelete(ctx context.Context, data *schema.ResourceData, meta
 
 	webhook_id := data.Id()
 
-	err := ci.Client.WebhookDelete(ci.HubID, webhook_id)
+	err := ci.Client.WebhookDelete(getHubID(data, ci), webhook_id)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -229,16 +320,19 @@ func resourceWebhookDelete(ctx context.Context, data *schema.ResourceData, meta
 	return diags
 }
 
-func resourceWebhookSaveState(data *schema.ResourceData, webhook content.Webhook) {
+func resourceWebhookSaveState(data *schema.ResourceData, hubID string, webhook content.Webhook) {
 	data.SetId(webhook.ID)
+	data.Set("hub_id", hubID)
 	data.Set("label", webhook.Label)
 	data.Set("events", webhook.Events)
 	data.Set("handlers", webhook.Handlers)
 	data.Set("active", webhook.Active)
 	data.Set("secret", webhook.Secret)
+	data.Set("notifications", flattenWebhookNotifications(webhook.Notifications))
+	data.Set("header", flattenWebhookHeaders(webhook.Headers, data.Get("header").([]interface{})))
 	data.Set("method", webhook.Method)
 	data.Set("filter", flattenWebhookFilters(&webhook.Filters))
-	data.Set("custom_payload", convertCustomPayloadToMap(webhook.CustomPayload))
+	data.Set("custom_payload", flattenWebhookCustomPayload(webhook.CustomPayload))
 }
 
 func createWebhookInput(data *schema.ResourceData) (*content.WebhookInput, error) {
@@ -253,28 +347,11 @@ func createWebhookInput(data *schema.ResourceData) (*content.WebhookInput, error
 		return nil, fmt.Errorf("could not create webhook draft filters: %w", err)
 	}
 
-	// Validation for custom payload is done in below function due to TypeMap constraints for TF provider
-	customPayload, err := resourceWebhookGetCustomPayloadAndValidate(data.Get("custom_payload"))
-	if err != nil {
-		return nil, fmt.Errorf("error getting custom payload: %w", err)
-	}
+	customPayload := resourceWebhookGetCustomPayload(data.Get("custom_payload"))
 
 	var eventSlice []string
 	for _, val := range data.Get("events").([]interface{}) {
-		event := val.(string)
-		if !StringInSlice([]string{
-			string(content.WebhookContentItemAssigned),
-			string(content.WebhookContentItemCreated),
-			string(content.WebhookContentItemUpdated),
-			string(content.WebhookContentItemWorkflowUpdated),
-			string(content.WebhookEditionPublished),
-			string(content.WebhookEditionScheduled),
-			string(content.WebhookEditionUnscheduled),
-			string(content.WebhookSnapshotPublished),
-		}, event) {
-			return nil, fmt.Errorf("invalid event type %s", event)
-		}
-		eventSlice = append(eventSlice, event)
+		eventSlice = append(eventSlice, val.(string))
 	}
 
 	var handlerSlice []string
@@ -366,10 +443,10 @@ func resourceWebhookGetFilters(input interface{}) ([]content.WebhookFilter, erro
 		filterArgsMap := i["arguments"].([]interface{})
 
 		switch filterType {
-		case "in":
+		case webhookFilterIn:
 			filter := resourceWebhookGetFilterIn(filterArgsMap)
 			result = append(result, filter)
-		case "equal":
+		case webhookFilterEqual:
 			filter := resourceWebhookGetFilterEqual(filterArgsMap)
 			result = append(result, filter)
 		default:
@@ -419,6 +496,20 @@ func resourceWebhookGetFilterEqual(argsMap []interface{}) content.WebhookFilterE
 	return result
 }
 
+func resourceWebhookGetCustomPayload(input interface{}) *content.WebhookCustomPayload {
+	for _, raw := range input.([]interface{}) {
+		i, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		return &content.WebhookCustomPayload{
+			Type:  i["type"].(string),
+			Value: i["value"].(string),
+		}
+	}
+	return nil
+}
+
 func resourceWebhookGetCustomPayloadAndValidate(input interface{}) (*content.WebhookCustomPayload, error) {
 	inputMap := input.(map[string]interface{})
 	payload := content.WebhookCustomPayload{}
@@ -440,6 +531,48 @@ func resourceWebhookGetCustomPayloadAndValidate(input interface{}) (*content.Web
 	return &payload, nil
 }
 
+func flattenWebhookNotifications(notifications []content.Notification) []interface{} {
+	result := make([]interface{}, len(notifications))
+	for i, notification := range notifications {
+		result[i] = map[string]interface{}{
+			"email": notification.Email,
+		}
+	}
+	return result
+}
+
+// flattenWebhookHeaders converts the headers of a webhook to their state. The API does not return the value of
+// secret headers, so the secret_value of a secret header is taken from the current state, matching on the key.
+func flattenWebhookHeaders(headers []content.WebhookHeader, current []interface{}) []interface{} {
+	secretValues := make(map[string]string)
+	for _, raw := range current {
+		header, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		key, _ := header["key"].(string)
+		if secretValue, ok := header["secret_value"].(string); ok && secretValue != "" {
+			secretValues[key] = secretValue
+		}
+	}
+
+	result := make([]interface{}, len(headers))
+	for i, header := range headers {
+		h := map[string]interface{}{
+			"key":          header.Key,
+			"value":        "",
+			"secret_value": "",
+		}
+		if header.Secret {
+			h["secret_value"] = secretValues[header.Key]
+		} else {
+			h["value"] = header.Value
+		}
+		result[i] = h
+	}
+	return result
+}
+
 func flattenWebhookFilters(filters *[]content.WebhookFilter) []interface{} {
 	if filters != nil {
 		fs := make([]interface{}, len(*filters))
@@ -449,12 +582,12 @@ func flattenWebhookFilters(filters *[]content.WebhookFilter) []interface{} {
 
 			switch v := filter.(type) {
 			case content.WebhookFilterEqual:
-				f["type"] = "equal"
+				f["type"] = webhookFilterEqual
 				f["arguments"] = flattenWebhookFilterEqualArguments(v.Value, v.JSONPath)
 				fs[i] = f
 
 			case content.WebhookFilterIn:
-				f["type"] = "in"
+				f["type"] = webhookFilterIn
 				f["arguments"] = flattenWebhookFilterInArguments(v.Values, v.JSONPath)
 				fs[i] = f
 			}
@@ -483,12 +616,14 @@ func flattenWebhookFilterInArguments(Values []string, JSONPath string) interface
 	return args
 }
 
-func convertCustomPayloadToMap(payload *content.WebhookCustomPayload) map[string]string {
-	if payload != nil {
-		return map[string]string{
+func flattenWebhookCustomPayload(payload *content.WebhookCustomPayload) []interface{} {
+	if payload == nil {
+		return []interface{}{}
+	}
+	return []interface{}{
+		map[string]interface{}{
 			"type":  payload.Type,
 			"value": payload.Value,
-		}
+		},
 	}
-	return nil
 }
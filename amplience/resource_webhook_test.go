@@ -1,12 +1,19 @@
 package amplience
 
 import (
+	"context"
 	"fmt"
+	"net/http"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/labd/amplience-go-sdk/content"
+	"github.com/labd/terraform-provider-amplience/internal/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestAccWebhooks_createAndUpdate(t *testing.T) {
@@ -75,9 +82,9 @@ func TestAccWebhooks_createAndUpdate(t *testing.T) {
 					resource.TestCheckResourceAttr(
 						"amplience_webhook.standard", "method", "POST"),
 					resource.TestCheckResourceAttr(
-						"amplience_webhook.standard", "custom_payload.type", "text/x-handlebars-template"),
+						"amplience_webhook.standard", "custom_payload.0.type", "text/x-handlebars-template"),
 					resource.TestCheckResourceAttr(
-						"amplience_webhook.standard", "custom_payload.value", "OPEN_INVERSE"),
+						"amplience_webhook.standard", "custom_payload.0.value", "OPEN_INVERSE"),
 				),
 			},
 			{
@@ -143,9 +150,9 @@ func TestAccWebhooks_createAndUpdate(t *testing.T) {
 					resource.TestCheckResourceAttr(
 						"amplience_webhook.standard", "method", "PATCH"),
 					resource.TestCheckResourceAttr(
-						"amplience_webhook.standard", "custom_payload.type", "text/x-handlebars-template"),
+						"amplience_webhook.standard", "custom_payload.0.type", "text/x-handlebars-template"),
 					resource.TestCheckResourceAttr(
-						"amplience_webhook.standard", "custom_payload.value", "OPEN_INVERSE"),
+						"amplience_webhook.standard", "custom_payload.0.value", "OPEN_INVERSE"),
 				),
 			},
 		},
@@ -200,7 +207,7 @@ func testAccWebhooksConfig(label string) string {
 
       method = "POST"
 
-      custom_payload = {
+      custom_payload {
         type = "text/x-handlebars-template"
         value = "OPEN_INVERSE"
       }
@@ -258,7 +265,7 @@ func testAccWebhookUpdate(label string) string {
 
       method = "PATCH"
 
-      custom_payload = {
+      custom_payload {
         type = "text/x-handlebars-template"
         value = "OPEN_INVERSE"
       }
@@ -271,3 +278,230 @@ func testAccWebhookUpdate(label string) string {
 func testAccWebhooksDestroy(s *terraform.State) error {
 	return nil
 }
+
+func TestWebhookNotificationsRoundTrip(t *testing.T) {
+	config := []interface{}{
+		map[string]interface{}{"email": "example.person@example.com"},
+	}
+
+	notifications := resourceWebhookGetNotifications(config)
+	assert.Equal(t, []content.Notification{{Email: "example.person@example.com"}}, notifications)
+	assert.Equal(t, config, flattenWebhookNotifications(notifications))
+
+	assert.Empty(t, flattenWebhookNotifications(nil))
+}
+
+func TestWebhookHeadersRoundTrip(t *testing.T) {
+	config := []interface{}{
+		map[string]interface{}{"key": "X-Additional-Header", "value": "abc123", "secret_value": ""},
+		map[string]interface{}{"key": "X-Secret-Header", "value": "", "secret_value": "321cba"},
+	}
+
+	headers, err := resourceWebhookGetHeaders(config)
+	require.NoError(t, err)
+	assert.Equal(t, []content.WebhookHeader{
+		{Key: "X-Additional-Header", Value: "abc123", Secret: false},
+		{Key: "X-Secret-Header", Value: "321cba", Secret: true},
+	}, headers)
+
+	// The API does not return the values of secret headers
+	headers[1].Value = ""
+	assert.Equal(t, config, flattenWebhookHeaders(headers, config))
+
+	// Without a state, for example after an import, the secret value is unknown
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"key": "X-Additional-Header", "value": "abc123", "secret_value": ""},
+		map[string]interface{}{"key": "X-Secret-Header", "value": "", "secret_value": ""},
+	}, flattenWebhookHeaders(headers, nil))
+}
+
+func TestWebhookFiltersRoundTrip(t *testing.T) {
+	config := []interface{}{
+		map[string]interface{}{
+			"type": "equal",
+			"arguments": []interface{}{
+				map[string]interface{}{"json_path": "$.payload.id", "value": []interface{}{"abc"}},
+			},
+		},
+		map[string]interface{}{
+			"type": "in",
+			"arguments": []interface{}{
+				map[string]interface{}{"json_path": "$.payload.id", "value": []interface{}{"abc", "123"}},
+			},
+		},
+	}
+
+	filters, err := resourceWebhookGetFilters(config)
+	require.NoError(t, err)
+	assert.Equal(t, []content.WebhookFilter{
+		content.WebhookFilterEqual{JSONPath: "$.payload.id", Value: "abc"},
+		content.WebhookFilterIn{JSONPath: "$.payload.id", Values: []string{"abc", "123"}},
+	}, filters)
+
+	data := resourceWebhook().TestResourceData()
+	require.NoError(t, data.Set("filter", flattenWebhookFilters(&filters)))
+	assert.Equal(t, config, data.Get("filter"))
+}
+
+func TestWebhookReadDetectsDrift(t *testing.T) {
+	ci := testutils.NewClientInfo(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		testutils.WriteJSON(w, http.StatusOK, map[string]interface{}{
+			"id":            "webhook-id",
+			"label":         "My webhook",
+			"events":        []string{"dynamic-content.content-item.updated"},
+			"handlers":      []string{"https://example.com/webhook"},
+			"method":        "POST",
+			"notifications": []map[string]interface{}{{"email": "changed@example.com"}},
+			"headers": []map[string]interface{}{
+				{"key": "X-Additional-Header", "value": "changed-in-ui", "secret": false},
+				{"key": "X-Secret-Header", "secret": true},
+			},
+		})
+	}))
+
+	data := schema.TestResourceDataRaw(t, resourceWebhook().Schema, map[string]interface{}{
+		"label":  "My webhook",
+		"method": "POST",
+		"notifications": []interface{}{
+			map[string]interface{}{"email": "example.person@example.com"},
+		},
+		"header": []interface{}{
+			map[string]interface{}{"key": "X-Additional-Header", "value": "abc123"},
+			map[string]interface{}{"key": "X-Secret-Header", "secret_value": "321cba"},
+		},
+	})
+	data.SetId("webhook-id")
+
+	diags := resourceWebhookRead(context.Background(), data, ci)
+	require.False(t, diags.HasError(), "%v", diags)
+
+	assert.Equal(t, "changed@example.com", data.Get("notifications.0.email"))
+	assert.Equal(t, "changed-in-ui", data.Get("header.0.value"))
+	assert.Equal(t, "X-Secret-Header", data.Get("header.1.key"))
+	assert.Equal(t, "321cba", data.Get("header.1.secret_value"))
+}
+
+func TestWebhookValidate(t *testing.T) {
+	tcs := []struct {
+		Name   string
+		Config map[string]interface{}
+		Error  string
+	}{
+		{
+			Name: "Valid",
+			Config: map[string]interface{}{
+				"events": []interface{}{"dynamic-content.content-item.updated", "dynamic-content.snapshot.published"},
+				"filter": []interface{}{
+					map[string]interface{}{
+						"type": "in",
+						"arguments": []interface{}{
+							map[string]interface{}{"json_path": "$.payload.id", "value": []interface{}{"abc"}},
+						},
+					},
+				},
+				"custom_payload": []interface{}{
+					map[string]interface{}{"type": "text/x-handlebars-template", "value": "{{payload.id}}"},
+				},
+			},
+		},
+		{
+			Name: "Unknown event",
+			Config: map[string]interface{}{
+				"events": []interface{}{"dynamic-content.content-item.deleted"},
+			},
+			Error: `got dynamic-content.content-item.deleted`,
+		},
+		{
+			Name: "Unknown filter type",
+			Config: map[string]interface{}{
+				"filter": []interface{}{
+					map[string]interface{}{
+						"type": "contains",
+						"arguments": []interface{}{
+							map[string]interface{}{"json_path": "$.payload.id", "value": []interface{}{"abc"}},
+						},
+					},
+				},
+			},
+			Error: `to be one of ["in" "equal"], got contains`,
+		},
+		{
+			Name: "Invalid custom payload template",
+			Config: map[string]interface{}{
+				"custom_payload": []interface{}{
+					map[string]interface{}{"type": "text/x-handlebars-template", "value": "{{#if payload.id}}{{/each}}"},
+				},
+			},
+			Error: "line 1, column 22: if doesn't match each",
+		},
+		{
+			Name: "Custom payload without value",
+			Config: map[string]interface{}{
+				"custom_payload": []interface{}{
+					map[string]interface{}{"type": "text/x-handlebars-template"},
+				},
+			},
+			Error: `The argument "custom_payload.0.value" is required`,
+		},
+	}
+
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			raw := map[string]interface{}{
+				"label":  "My webhook",
+				"method": "POST",
+			}
+			for key, value := range tc.Config {
+				raw[key] = value
+			}
+
+			diags := resourceWebhook().Validate(terraform.NewResourceConfigRaw(raw))
+			if tc.Error == "" {
+				assert.False(t, diags.HasError(), "%v", diags)
+				return
+			}
+			require.True(t, diags.HasError())
+			assert.Contains(t, diags[0].Summary+diags[0].Detail, tc.Error)
+		})
+	}
+}
+
+func TestWebhookStateUpgradeV0(t *testing.T) {
+	tcs := []struct {
+		Name     string
+		Payload  interface{}
+		Expected []interface{}
+	}{
+		{
+			Name: "Custom payload",
+			Payload: map[string]interface{}{
+				"type":  "text/x-handlebars-template",
+				"value": "{{payload.id}}",
+			},
+			Expected: []interface{}{
+				map[string]interface{}{"type": "text/x-handlebars-template", "value": "{{payload.id}}"},
+			},
+		},
+		{
+			Name:     "No custom payload",
+			Payload:  nil,
+			Expected: []interface{}{},
+		},
+	}
+
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			state, err := resourceWebhookStateUpgradeV0(context.Background(), map[string]interface{}{
+				"label":          "My webhook",
+				"custom_payload": tc.Payload,
+			}, nil)
+			require.NoError(t, err)
+			assert.Equal(t, "My webhook", state["label"])
+			assert.Equal(t, tc.Expected, state["custom_payload"])
+		})
+	}
+}
@@ -1,29 +1,166 @@
 package amplience
 
 import (
+	"context"
 	"fmt"
-	"strings"
+	"log"
 
-	"github.com/labd/amplience-go-sdk/content"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/labd/terraform-provider-amplience/internal/config"
+	"github.com/labd/terraform-provider-amplience/internal/utils"
 )
 
-func parseID(id string) (hub_id string, resource_id string) {
-	values := strings.SplitN(id, ":", 2)
-	if len(values) > 1 {
-		return values[0], values[1]
+func getClient(meta interface{}) *config.ClientInfo {
+	return meta.(*config.ClientInfo)
+}
+
+// apiErrorDiagnostics returns an error diagnostic for a failed API call, including the errors returned by the API
+func apiErrorDiagnostics(summary string, err error) diag.Diagnostics {
+	return diag.Diagnostics{{
+		Severity: diag.Error,
+		Summary:  summary,
+		Detail:   utils.ErrorDetail(err),
+	}}
+}
+
+// alreadyExistsDiagnostics returns an error diagnostic for a create that conflicted with an existing object, which is
+// not adopted because adopt_existing is disabled
+func alreadyExistsDiagnostics(kind string, key string, err error) diag.Diagnostics {
+	return diag.Diagnostics{{
+		Severity: diag.Error,
+		Summary:  fmt.Sprintf("A %s %s already exists", kind, key),
+		Detail: fmt.Sprintf("Import the existing %s, or set adopt_existing to true to take it over.\n\n%s",
+			kind, utils.ErrorDetail(err)),
+	}}
+}
+
+// adoptExistingSchema is the schema of the adopt_existing attribute of resources that can take over an existing
+// object with the same unique key on create
+func adoptExistingSchema(kind string, key string) *schema.Schema {
+	return &schema.Schema{
+		Description: fmt.Sprintf("When an active %s with the same %s already exists in the hub, adopt it on create "+
+			"and update it to match this configuration. When disabled, creating the resource fails in that case, and "+
+			"the existing %s should be imported instead. An archived %s with the same %s is always unarchived and "+
+			"reused", kind, key, kind, kind, key),
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+	}
+}
+
+// removeFromState clears the ID of a resource that no longer exists in Amplience, so Terraform plans to recreate it
+// instead of failing the refresh
+func removeFromState(data *schema.ResourceData, kind string) diag.Diagnostics {
+	log.Printf("[WARN] %s %s no longer exists, removing it from state", kind, data.Id())
+	data.SetId("")
+	return nil
+}
+
+// hubIDSchema is the schema of the optional hub_id attribute of hub-scoped resources and data sources
+func hubIDSchema() *schema.Schema {
+	return &schema.Schema{
+		Description:      "ID of the Hub to manage this resource in. Defaults to the `hub_id` of the provider",
+		Type:             schema.TypeString,
+		Optional:         true,
+		Computed:         true,
+		ForceNew:         true,
+		ValidateDiagFunc: ValidateDiagWrapper(validation.StringDoesNotContainAny(" ")),
+	}
+}
+
+// getHubID returns the hub_id of the resource, falling back to the hub_id of the provider when it is not set. This
+// is also the case for resources imported or created before hub_id existed.
+func getHubID(data *schema.ResourceData, ci *config.ClientInfo) string {
+	if hubID, ok := data.GetOk("hub_id"); ok {
+		return hubID.(string)
+	}
+	return ci.HubID
+}
+
+// requireHubID is getHubID for Create and import, where the hub is chosen. It returns utils.ErrMissingHubID when
+// neither the resource nor the provider sets a hub_id.
+func requireHubID(data *schema.ResourceData, ci *config.ClientInfo) (string, error) {
+	if hubID := getHubID(data, ci); hubID != "" {
+		return hubID, nil
 	}
-	return "", values[0]
+	return "", utils.ErrMissingHubID
 }
 
-func createID(hub_id string, resource_id string) string {
-	return fmt.Sprintf("%s:%s", hub_id, resource_id)
+// importHubScopedResource imports a resource using either its ID or a hub_id:resource_id ID, so resources can be
+// imported from another hub than the one configured on the provider
+func importHubScopedResource(ctx context.Context, data *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	hubID, resourceID := utils.ParseID(data.Id())
+	if hubID == "" {
+		hubID = getClient(meta).HubID
+	}
+	if hubID == "" {
+		return nil, utils.ErrMissingHubID
+	}
+
+	data.SetId(resourceID)
+	if err := data.Set("hub_id", hubID); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{data}, nil
 }
 
-type ClientInfo struct {
-	Client *content.Client
-	HubID  string
+// importHubScopedResourceByKey returns an importer like importHubScopedResource for resources that can also be
+// imported by a natural key, such as a content type URI. get checks that the resource with the given ID can be
+// imported and resolve returns the ID of the resource with the given key, see utils.ResolveImportID.
+func importHubScopedResourceByKey(
+	get func(ci *config.ClientInfo, id string) error,
+	resolve func(ctx context.Context, ci *config.ClientInfo, hubID string, key string) (string, error),
+) schema.StateContextFunc {
+	return func(ctx context.Context, data *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+		result, err := importHubScopedResource(ctx, data, meta)
+		if err != nil {
+			return nil, err
+		}
+
+		ci := getClient(meta)
+		id, err := utils.ResolveImportID(data.Id(),
+			func(id string) error { return get(ci, id) },
+			func(key string) (string, error) { return resolve(ctx, ci, data.Get("hub_id").(string), key) },
+		)
+		if err != nil {
+			return nil, err
+		}
+		data.SetId(id)
+		return result, nil
+	}
 }
 
-func getClient(meta interface{}) *ClientInfo {
-	return meta.(*ClientInfo)
+// toStringSlice converts the value of a list or set of strings
+func toStringSlice(values []interface{}) []string {
+	result := make([]string, 0, len(values))
+	for _, value := range values {
+		result = append(result, value.(string))
+	}
+	return result
+}
+
+// orderLike returns values in the order of reference, followed by the values that are not in reference. This keeps
+// the order of a list in the configuration when the API returns the items in a different order.
+func orderLike(values []string, reference []string) []string {
+	remaining := map[string]int{}
+	for _, value := range values {
+		remaining[value]++
+	}
+
+	result := make([]string, 0, len(values))
+	for _, value := range reference {
+		if remaining[value] > 0 {
+			remaining[value]--
+			result = append(result, value)
+		}
+	}
+	for _, value := range values {
+		if remaining[value] > 0 {
+			remaining[value]--
+			result = append(result, value)
+		}
+	}
+	return result
 }
@@ -1 +1,326 @@
 package amplience
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/labd/terraform-provider-amplience/internal/config"
+	"github.com/labd/terraform-provider-amplience/internal/testutils"
+	"github.com/labd/terraform-provider-amplience/internal/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImportHubScopedResource(t *testing.T) {
+	t.Parallel()
+	tcs := []struct {
+		Name       string
+		ImportID   string
+		HubID      string
+		ResourceID string
+	}{
+		{
+			Name:       "Uses the provider hub when importing by resource ID",
+			ImportID:   "5f7d5f7d5f7d5f7d5f7d5f7d",
+			HubID:      "provider-hub",
+			ResourceID: "5f7d5f7d5f7d5f7d5f7d5f7d",
+		},
+		{
+			Name:       "Uses the given hub when importing by hub_id:resource_id",
+			ImportID:   "other-hub:5f7d5f7d5f7d5f7d5f7d5f7d",
+			HubID:      "other-hub",
+			ResourceID: "5f7d5f7d5f7d5f7d5f7d5f7d",
+		},
+	}
+
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			ci := &config.ClientInfo{HubID: "provider-hub"}
+
+			data := schema.TestResourceDataRaw(t, resourceWebhook().Schema, map[string]interface{}{})
+			data.SetId(tc.ImportID)
+
+			result, err := importHubScopedResource(context.Background(), data, ci)
+			require.NoError(t, err)
+			require.Len(t, result, 1)
+
+			assert.Equal(t, tc.ResourceID, result[0].Id())
+			assert.Equal(t, tc.HubID, result[0].Get("hub_id"))
+			assert.Equal(t, tc.HubID, getHubID(result[0], ci))
+		})
+	}
+}
+
+func TestImportHubScopedResourceWithoutHub(t *testing.T) {
+	t.Parallel()
+	ci := &config.ClientInfo{}
+
+	data := schema.TestResourceDataRaw(t, resourceWebhook().Schema, map[string]interface{}{})
+	data.SetId("5f7d5f7d5f7d5f7d5f7d5f7d")
+
+	_, err := importHubScopedResource(context.Background(), data, ci)
+	assert.ErrorIs(t, err, utils.ErrMissingHubID)
+}
+
+func TestImportByNaturalKey(t *testing.T) {
+	t.Parallel()
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/content-types/5f7d5f7d5f7d5f7d5f7d5f7d":
+			testutils.WriteJSON(w, http.StatusOK, map[string]interface{}{"id": "5f7d5f7d5f7d5f7d5f7d5f7d"})
+		case "/content-types/8c0a8c0a8c0a8c0a8c0a8c0a":
+			testutils.WriteJSON(w, http.StatusOK, map[string]interface{}{"id": "8c0a8c0a8c0a8c0a8c0a8c0a", "status": "ARCHIVED"})
+		case "/content-repositories/abcdefabcdefabcdefabcdef":
+			testutils.NotFound(w, r)
+		case "/hubs/other-hub/content-types", "/hubs/provider-hub/content-types":
+			testutils.WriteJSON(w, http.StatusOK, map[string]interface{}{
+				"_embedded": map[string]interface{}{
+					"content-types": []map[string]interface{}{
+						{"id": "5f7d5f7d5f7d5f7d5f7d5f7d", "contentTypeUri": "https://schema.example.com/banner.json"},
+						{"id": "6a8e6a8e6a8e6a8e6a8e6a8e", "contentTypeUri": "https://schema.example.com/card.json"},
+						{"id": "7b9f7b9f7b9f7b9f7b9f7b9f", "contentTypeUri": "https://schema.example.com/card.json"},
+						{"id": "8c0a8c0a8c0a8c0a8c0a8c0a", "contentTypeUri": "https://schema.example.com/old.json", "status": "ARCHIVED"},
+					},
+				},
+				"page": map[string]interface{}{"totalPages": 1},
+			})
+		case "/hubs/provider-hub/content-repositories":
+			testutils.WriteJSON(w, http.StatusOK, map[string]interface{}{
+				"_embedded": map[string]interface{}{
+					"content-repositories": []map[string]interface{}{
+						{"id": "5f7d5f7d5f7d5f7d5f7d5f7d", "name": "content"},
+						{"id": "5f7d5f7d5f7d5f7d5f7d5f7d", "name": "abcdefabcdefabcdefabcdef"},
+					},
+				},
+				"page": map[string]interface{}{"totalPages": 1},
+			})
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+			testutils.NotFound(w, r)
+		}
+	}
+
+	tcs := []struct {
+		Name     string
+		Resource *schema.Resource
+		ImportID string
+		HubID    string
+		Error    string
+	}{
+		{
+			Name:     "Content type by ID",
+			Resource: resourceContentType(),
+			ImportID: "5f7d5f7d5f7d5f7d5f7d5f7d",
+			HubID:    "provider-hub",
+		},
+		{
+			Name:     "Content type by URI",
+			Resource: resourceContentType(),
+			ImportID: "https://schema.example.com/banner.json",
+			HubID:    "provider-hub",
+		},
+		{
+			Name:     "Content type by URI in another hub",
+			Resource: resourceContentType(),
+			ImportID: "other-hub:https://schema.example.com/banner.json",
+			HubID:    "other-hub",
+		},
+		{
+			Name:     "Content type by ambiguous URI",
+			Resource: resourceContentType(),
+			ImportID: "https://schema.example.com/card.json",
+			Error:    "matches more than one content type: 6a8e6a8e6a8e6a8e6a8e6a8e, 7b9f7b9f7b9f7b9f7b9f7b9f",
+		},
+		{
+			Name:     "Archived content type by ID",
+			Resource: resourceContentType(),
+			ImportID: "8c0a8c0a8c0a8c0a8c0a8c0a",
+			Error:    "content type 8c0a8c0a8c0a8c0a8c0a8c0a is archived",
+		},
+		{
+			Name:     "Archived content type by URI",
+			Resource: resourceContentType(),
+			ImportID: "https://schema.example.com/old.json",
+			Error:    `the content type with content_type_uri "https://schema.example.com/old.json" is archived`,
+		},
+		{
+			Name:     "Content repository by name",
+			Resource: resourceContentRepository(),
+			ImportID: "content",
+			HubID:    "provider-hub",
+		},
+		{
+			Name:     "Content repository named like an ID",
+			Resource: resourceContentRepository(),
+			ImportID: "abcdefabcdefabcdefabcdef",
+			HubID:    "provider-hub",
+		},
+		{
+			Name:     "Content repository by unknown name",
+			Resource: resourceContentRepository(),
+			ImportID: "missing",
+			Error:    `no content repository found with name "missing"`,
+		},
+	}
+
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			ci := testutils.NewClientInfo(t, http.HandlerFunc(handler))
+			ci.HubID = "provider-hub"
+
+			data := tc.Resource.TestResourceData()
+			data.SetId(tc.ImportID)
+
+			result, err := tc.Resource.Importer.StateContext(context.Background(), data, ci)
+			if tc.Error != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.Error)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, result, 1)
+			assert.Equal(t, "5f7d5f7d5f7d5f7d5f7d5f7d", result[0].Id())
+			assert.Equal(t, tc.HubID, result[0].Get("hub_id"))
+		})
+	}
+}
+
+func TestGetHubIDFallsBackToProvider(t *testing.T) {
+	t.Parallel()
+	ci := &config.ClientInfo{HubID: "provider-hub"}
+
+	data := schema.TestResourceDataRaw(t, resourceWebhook().Schema, map[string]interface{}{})
+	assert.Equal(t, "provider-hub", getHubID(data, ci))
+
+	data = schema.TestResourceDataRaw(t, resourceWebhook().Schema, map[string]interface{}{"hub_id": "resource-hub"})
+	assert.Equal(t, "resource-hub", getHubID(data, ci))
+}
+
+func TestRequireHubID(t *testing.T) {
+	t.Parallel()
+
+	data := schema.TestResourceDataRaw(t, resourceWebhook().Schema, map[string]interface{}{"hub_id": "resource-hub"})
+	hubID, err := requireHubID(data, &config.ClientInfo{})
+	require.NoError(t, err)
+	assert.Equal(t, "resource-hub", hubID)
+
+	data = schema.TestResourceDataRaw(t, resourceWebhook().Schema, map[string]interface{}{})
+	_, err = requireHubID(data, &config.ClientInfo{})
+	assert.ErrorIs(t, err, utils.ErrMissingHubID)
+}
+
+func TestReadRemovesMissingResources(t *testing.T) {
+	t.Parallel()
+	archived := func(w http.ResponseWriter, r *http.Request) {
+		testutils.WriteJSON(w, http.StatusOK, map[string]interface{}{
+			"id":     "5f7d5f7d5f7d5f7d5f7d5f7d",
+			"status": "ARCHIVED",
+		})
+	}
+
+	tcs := []struct {
+		Name     string
+		Resource *schema.Resource
+		Handler  http.HandlerFunc
+	}{
+		{
+			Name:     "Webhook not found",
+			Resource: resourceWebhook(),
+			Handler:  testutils.NotFound,
+		},
+		{
+			Name:     "Search index not found",
+			Resource: resourceSearchIndex(),
+			Handler:  testutils.NotFound,
+		},
+		{
+			Name:     "Content repository not found",
+			Resource: resourceContentRepository(),
+			Handler:  testutils.NotFound,
+		},
+		{
+			Name:     "Content type not found",
+			Resource: resourceContentType(),
+			Handler:  testutils.NotFound,
+		},
+		{
+			Name:     "Content type archived",
+			Resource: resourceContentType(),
+			Handler:  archived,
+		},
+	}
+
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			ci := testutils.NewClientInfo(t, tc.Handler)
+
+			data := tc.Resource.TestResourceData()
+			data.SetId("5f7d5f7d5f7d5f7d5f7d5f7d")
+
+			diags := tc.Resource.ReadContext(context.Background(), data, ci)
+			assert.False(t, diags.HasError(), "%v", diags)
+			assert.Empty(t, data.Id())
+		})
+	}
+}
+
+func TestReadKeepsResourceOnOtherErrors(t *testing.T) {
+	t.Parallel()
+	ci := testutils.NewClientInfo(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		testutils.WriteJSON(w, http.StatusForbidden, map[string]interface{}{
+			"errors": []map[string]interface{}{{"message": "Authorization required."}},
+		})
+	}))
+
+	data := resourceWebhook().TestResourceData()
+	data.SetId("5f7d5f7d5f7d5f7d5f7d5f7d")
+
+	diags := resourceWebhookRead(context.Background(), data, ci)
+	assert.True(t, diags.HasError())
+	assert.Equal(t, "5f7d5f7d5f7d5f7d5f7d5f7d", data.Id())
+}
+
+func TestOrderLike(t *testing.T) {
+	t.Parallel()
+	tcs := []struct {
+		Name      string
+		Values    []string
+		Reference []string
+		Expected  []string
+	}{
+		{
+			Name:      "Keeps the order of the reference",
+			Values:    []string{"c", "a", "b"},
+			Reference: []string{"a", "b", "c"},
+			Expected:  []string{"a", "b", "c"},
+		},
+		{
+			Name:      "Adds values that are not in the reference",
+			Values:    []string{"d", "b", "a"},
+			Reference: []string{"a", "b", "c"},
+			Expected:  []string{"a", "b", "d"},
+		},
+		{
+			Name:      "Empty reference",
+			Values:    []string{"b", "a"},
+			Reference: nil,
+			Expected:  []string{"b", "a"},
+		},
+	}
+
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.Expected, orderLike(tc.Values, tc.Reference))
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/labd/terraform-provider-amplience/internal/utils"
 )
 
 // ValidateDiagWrapper wraps a deprecated schema.ValidateFunc and returns a schema.SchemaValidateDiagFunc
@@ -31,6 +32,52 @@ func ValidateDiagWrapper(validateFunc func(interface{}, string) ([]string, []err
 	}
 }
 
+// ValidateHandlebarsTemplate checks the syntax of a Handlebars template, such as the custom payload of a webhook
+func ValidateHandlebarsTemplate(i interface{}, path cty.Path) diag.Diagnostics {
+	template, ok := i.(string)
+	if !ok {
+		return diag.Errorf("expected a string, got %T", i)
+	}
+
+	if err := utils.ValidateHandlebars(template); err != nil {
+		return diag.Diagnostics{
+			{
+				Severity:      diag.Error,
+				Summary:       "Invalid Handlebars template",
+				Detail:        err.Error(),
+				AttributePath: path,
+			},
+		}
+	}
+	return nil
+}
+
+// ValidateCustomPayloadMap checks a custom payload given as a map, which may only have a type and a value. The value
+// must be a valid Handlebars template.
+func ValidateCustomPayloadMap(i interface{}, path cty.Path) diag.Diagnostics {
+	payload, ok := i.(map[string]interface{})
+	if !ok {
+		return diag.Errorf("expected a map, got %T", i)
+	}
+
+	var diags diag.Diagnostics
+	for key, value := range payload {
+		switch key {
+		case "type":
+		case "value":
+			diags = append(diags, ValidateHandlebarsTemplate(value, path.IndexString(key))...)
+		default:
+			diags = append(diags, diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       fmt.Sprintf("unknown key %s in custom payload field", key),
+				Detail:        "A custom payload may only have a type and a value.",
+				AttributePath: path,
+			})
+		}
+	}
+	return diags
+}
+
 // StringInSlice takes a slice and looks for an element in it. If found it will return true
 // can be used to manually validate elements in a list in the create/update process as terraform validation functions
 // are not designed for lists
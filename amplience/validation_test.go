@@ -3,6 +3,7 @@ package amplience
 import (
 	"testing"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -45,3 +46,47 @@ func TestStringInSlice(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateCustomPayloadMap(t *testing.T) {
+	t.Parallel()
+	tcs := []struct {
+		Name    string
+		Payload map[string]interface{}
+		Errors  []string
+	}{
+		{
+			Name: "Valid payload",
+			Payload: map[string]interface{}{
+				"type":  "text/x-handlebars-template",
+				"value": `{"id": "{{payload.id}}"}`,
+			},
+		},
+		{
+			Name: "Invalid template",
+			Payload: map[string]interface{}{
+				"type":  "text/x-handlebars-template",
+				"value": "{\n  \"id\": \"{{payload.id}\"\n}",
+			},
+			Errors: []string{"line 2, column 22: Lexer error: Unexpected character in expression: '}'"},
+		},
+		{
+			Name: "Unknown key",
+			Payload: map[string]interface{}{
+				"template": "{{payload.id}}",
+			},
+			Errors: []string{"A custom payload may only have a type and a value."},
+		},
+	}
+
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			var errs []string
+			for _, d := range ValidateCustomPayloadMap(tc.Payload, cty.GetAttrPath("webhook_custom_payload")) {
+				errs = append(errs, d.Detail)
+			}
+			assert.Equal(t, tc.Errors, errs)
+		})
+	}
+}
@@ -0,0 +1,5 @@
+data "amplience_content_repositories" "all" {}
+
+output "content_repository_id" {
+  value = data.amplience_content_repositories.all.ids["content"]
+}
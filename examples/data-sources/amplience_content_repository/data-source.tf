@@ -1,3 +1,7 @@
 data "amplience_content_repository" "my-content-repository" {
   id = "my-id"
 }
+
+data "amplience_content_repository" "content" {
+  name = "content"
+}
@@ -0,0 +1,3 @@
+data "amplience_content_type" "banner" {
+  content_type_uri = "https://schema.example.com/banner.json"
+}
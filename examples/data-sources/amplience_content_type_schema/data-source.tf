@@ -0,0 +1,3 @@
+data "amplience_content_type_schema" "banner" {
+  schema_id = "https://schema.example.com/banner.json"
+}
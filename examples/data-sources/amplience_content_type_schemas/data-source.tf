@@ -0,0 +1,7 @@
+data "amplience_content_type_schemas" "active" {
+  status = "ACTIVE"
+}
+
+output "schema_versions" {
+  value = { for s in data.amplience_content_type_schemas.active.content_type_schemas : s.schema_id => s.version }
+}
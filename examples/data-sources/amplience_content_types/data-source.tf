@@ -0,0 +1,7 @@
+data "amplience_content_types" "archived" {
+  status = "ARCHIVED"
+}
+
+output "archived_content_types" {
+  value = keys(data.amplience_content_types.archived.ids)
+}
@@ -1,3 +1,7 @@
 data "amplience_hub" "my-hub" {
   id = "my-id"
 }
+
+output "publishing_endpoint" {
+  value = data.amplience_hub.my-hub.settings.publishing.platforms.amplience_dam.endpoint
+}
@@ -0,0 +1,5 @@
+data "amplience_search_indexes" "all" {}
+
+output "search_index_content_types" {
+  value = { for index in data.amplience_search_indexes.all.search_indexes : index.name => index.content_types }
+}
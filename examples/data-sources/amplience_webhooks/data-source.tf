@@ -0,0 +1,16 @@
+data "amplience_webhooks" "all" {}
+
+locals {
+  allowed_webhook_domains = ["hooks.example.com", "search.example.com"]
+}
+
+check "webhook_domains" {
+  assert {
+    condition = alltrue(flatten([
+      for webhook in data.amplience_webhooks.all.webhooks : [
+        for handler in webhook.handlers : contains(local.allowed_webhook_domains, regex("^https?://([^/:]+)", handler)[0])
+      ]
+    ]))
+    error_message = "A webhook posts to a domain that is not allowlisted."
+  }
+}
@@ -0,0 +1,5 @@
+data "amplience_workflow_states" "all" {}
+
+output "approved_workflow_state_id" {
+  value = data.amplience_workflow_states.all.ids["Approved"]
+}
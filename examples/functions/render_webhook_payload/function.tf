@@ -0,0 +1,12 @@
+locals {
+  payload = provider::amplience::render_webhook_payload(
+    amplience_webhook.my-webhook.custom_payload[0].value,
+    jsonencode({
+      name = "dynamic-content.content-item.updated"
+      payload = {
+        id    = "00112233-4455-6677-8899-aabbccddeeff"
+        label = "My content item"
+      }
+    })
+  )
+}
@@ -0,0 +1,2 @@
+# Content items can be imported using either <id> or <hub_id>:<id>
+terraform import amplience_content_item.navigation my-content-item-id
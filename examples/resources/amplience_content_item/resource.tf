@@ -0,0 +1,15 @@
+resource "amplience_content_item" "navigation" {
+  repository_id = data.amplience_content_repository.website.id
+  folder_id     = amplience_folder.navigation.id
+  schema_id     = amplience_content_type_schema.navigation.schema_id
+  label         = "Main navigation"
+  locale        = "en-GB"
+  delivery_key  = "navigation/main"
+  body = jsonencode({
+    title = "Main navigation"
+    links = [
+      { label = "Home", url = "/" },
+      { label = "Sale", url = "/sale" },
+    ]
+  })
+}
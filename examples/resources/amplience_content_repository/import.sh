@@ -0,0 +1,3 @@
+# Content repositories can be imported using either their <id> or their <name>, optionally prefixed with <hub_id>:
+# A name that looks like an ID is resolved as a name when no repository has that ID.
+terraform import amplience_content_repository.my-content-repository content
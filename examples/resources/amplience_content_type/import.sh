@@ -0,0 +1,3 @@
+# Content types can be imported using either their <id> or their <content_type_uri>, optionally prefixed with <hub_id>:
+# Archived content types must be unarchived in Amplience first.
+terraform import amplience_content_type.my-content-type https://schema.example.com/banner.json
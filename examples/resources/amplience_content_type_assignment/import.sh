@@ -0,0 +1,2 @@
+# Content type assignments can be imported using <repository_id>:<content_type_id>
+terraform import amplience_content_type_assignment.my-content-type-assignment my-repository-id:my-content-type-id
@@ -0,0 +1,3 @@
+# Content type schemas can be imported using either their <id> or their <schema_id>, optionally prefixed with <hub_id>:
+# Archived schemas must be unarchived in Amplience first.
+terraform import amplience_content_type_schema.tag https://schema.example.com/tag.json
@@ -0,0 +1,2 @@
+# Editions can be imported using their ID
+terraform import amplience_edition.early-deals my-edition-id
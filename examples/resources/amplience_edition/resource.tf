@@ -0,0 +1,7 @@
+resource "amplience_edition" "early-deals" {
+  event_id        = amplience_event.black-friday.id
+  name            = "Early deals"
+  start           = amplience_event.black-friday.start
+  end             = "2026-11-28T00:00:00Z"
+  active_end_date = true
+}
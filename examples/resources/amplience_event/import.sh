@@ -0,0 +1,2 @@
+# Events can be imported using either <id> or <hub_id>:<id>
+terraform import amplience_event.black-friday my-event-id
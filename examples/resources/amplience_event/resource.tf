@@ -0,0 +1,7 @@
+resource "amplience_event" "black-friday" {
+  name    = "Black Friday"
+  start   = "2026-11-27T00:00:00Z"
+  end     = "2026-11-30T23:59:59Z"
+  brief   = "https://wiki.example.com/campaigns/black-friday"
+  comment = "Deals on everything"
+}
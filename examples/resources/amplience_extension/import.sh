@@ -0,0 +1,2 @@
+# Extensions can be imported using either <id> or <hub_id>:<id>
+terraform import amplience_extension.product-picker my-extension-id
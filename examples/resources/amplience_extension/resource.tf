@@ -0,0 +1,21 @@
+resource "amplience_extension" "product-picker" {
+  name     = "product-picker"
+  label    = "Product picker"
+  url      = "https://extensions.example.com/product-picker"
+  category = "CONTENT_FIELD"
+  height   = 400
+
+  parameters = jsonencode({
+    apiKey = var.commerce_api_key
+  })
+
+  snippets = [
+    {
+      label = "No products"
+      body  = jsonencode([])
+    },
+  ]
+
+  permissions     = ["SAME_ORIGIN", "MODALS"]
+  api_permissions = ["READ"]
+}
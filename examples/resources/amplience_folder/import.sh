@@ -0,0 +1,2 @@
+# Folders can be imported using <repository_id>:<folder_id>
+terraform import amplience_folder.navigation my-repository-id:my-folder-id
@@ -0,0 +1,10 @@
+resource "amplience_folder" "site" {
+  repository_id = data.amplience_content_repository.website.id
+  name          = "Site"
+}
+
+resource "amplience_folder" "navigation" {
+  repository_id    = data.amplience_content_repository.website.id
+  parent_folder_id = amplience_folder.site.id
+  name             = "Navigation"
+}
@@ -0,0 +1,3 @@
+# Search index replicas can be imported using either <id> or <hub_id>:<id>
+# All settings of the replica are imported, so the next plan shows how they differ from the config.
+terraform import amplience_search_index_replica.products-by-price my-replica-id
@@ -0,0 +1,8 @@
+resource "amplience_search_index_replica" "products-by-price" {
+  index_id = amplience_search_index.products.id
+  label    = "Products by price"
+  suffix   = "by-price"
+  settings = jsonencode({
+    customRanking = ["asc(price)"]
+  })
+}
@@ -1,4 +1,25 @@
 resource "amplience_webhook" "my-webhook" {
   label  = "my-label"
   method = "POST"
+
+  events = [
+    "dynamic-content.content-item.created",
+    "dynamic-content.content-item.updated",
+  ]
+  handlers = [
+    "https://example.com/webhook",
+  ]
+
+  filter {
+    type = "equal"
+    arguments {
+      json_path = "$.payload.id"
+      value     = ["abc"]
+    }
+  }
+
+  custom_payload {
+    type  = "text/x-handlebars-template"
+    value = "{\"id\": \"{{{payload.id}}}\"}"
+  }
 }
@@ -0,0 +1,2 @@
+# Workflow states can be imported using either <id> or <hub_id>:<id>
+terraform import amplience_workflow_state.in-review my-workflow-state-id
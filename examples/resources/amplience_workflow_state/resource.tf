@@ -0,0 +1,4 @@
+resource "amplience_workflow_state" "in-review" {
+  label = "In review"
+  color = "rgb(255,165,0)"
+}
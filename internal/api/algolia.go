@@ -0,0 +1,77 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/labd/amplience-go-sdk/content"
+)
+
+// AlgoliaReplicaInput is used to create a replica of a search index
+type AlgoliaReplicaInput struct {
+	Suffix string `json:"suffix"`
+	Label  string `json:"label"`
+}
+
+func algoliaIndexPath(hubID string, indexID string) string {
+	return fmt.Sprintf("/algolia-search/%s/indexes/%s", hubID, indexID)
+}
+
+// AlgoliaIndexSettingsGet returns all Algolia settings of a search index. The SDK only supports a part of the
+// settings.
+func (c *Client) AlgoliaIndexSettingsGet(ctx context.Context, hubID string, indexID string) (map[string]interface{}, error) {
+	var result map[string]interface{}
+	err := c.Get(ctx, algoliaIndexPath(hubID, indexID)+"/settings", &result)
+	return result, err
+}
+
+// AlgoliaIndexSettingsUpdate updates the Algolia settings in settings, a JSON object, of a search index
+func (c *Client) AlgoliaIndexSettingsUpdate(ctx context.Context, hubID string, indexID string, settings json.RawMessage) error {
+	return c.Patch(ctx, algoliaIndexPath(hubID, indexID)+"/settings", settings, nil)
+}
+
+// AlgoliaReplicaCreate creates a replica of the search index indexID. The replica is a search index itself, so it is
+// read, updated and deleted like any other search index.
+func (c *Client) AlgoliaReplicaCreate(ctx context.Context, hubID string, indexID string, input AlgoliaReplicaInput) (content.AlgoliaIndex, error) {
+	var result content.AlgoliaIndex
+	err := c.Post(ctx, algoliaIndexPath(hubID, indexID)+"/replicas", input, &result)
+	return result, err
+}
+
+type algoliaIndexResults struct {
+	Embedded struct {
+		Indexes []content.AlgoliaIndex `json:"indexes"`
+	} `json:"_embedded"`
+	Page pageInformation `json:"page"`
+}
+
+// AlgoliaIndexGetAll returns all search indexes of a hub, including replicas
+func (c *Client) AlgoliaIndexGetAll(ctx context.Context, hubID string) ([]content.AlgoliaIndex, error) {
+	var result []content.AlgoliaIndex
+	for page := 0; ; page++ {
+		var response algoliaIndexResults
+		err := c.Get(ctx, fmt.Sprintf("/algolia-search/%s/indexes?%s", hubID, pageQuery(page)), &response)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, response.Embedded.Indexes...)
+		if page >= response.Page.TotalPages-1 {
+			return result, nil
+		}
+	}
+}
+
+// AlgoliaIndexAssignedContentTypes returns the content types assigned to a search index. Amplience creates a webhook
+// for every assigned content type, see WebhookID.
+func (c *Client) AlgoliaIndexAssignedContentTypes(ctx context.Context, hubID string, indexID string) ([]content.AssignedContentType, error) {
+	var result content.AssignedContentTypeResults
+	err := c.Get(ctx, algoliaIndexPath(hubID, indexID)+"/assigned-content-types", &result)
+	return result.Items, err
+}
+
+// WebhookID returns the ID of the webhook Amplience created for a content type assigned to a search index, or an
+// empty string when there is none
+func WebhookID(assigned content.AssignedContentType) string {
+	return linkedID(assigned.Links, "webhook")
+}
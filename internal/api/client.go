@@ -0,0 +1,137 @@
+// Package api calls the endpoints of the Amplience API that are not (correctly) available in the Amplience SDK. Errors
+// are returned as a *content.ErrorResponse, like the SDK does, so they can be handled the same way.
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/labd/amplience-go-sdk/content"
+)
+
+const DefaultURL = "https://api.amplience.net/v2/content"
+
+// Client is a client for the Amplience content API
+type Client struct {
+	url        string
+	httpClient *http.Client
+}
+
+// NewClient creates a Client for the API at baseURL. The httpClient should authenticate the requests, so the provider
+// can share a single OAuth token between this client and the Amplience SDK.
+func NewClient(baseURL string, httpClient *http.Client) *Client {
+	if baseURL == "" {
+		baseURL = DefaultURL
+	}
+
+	return &Client{
+		url:        strings.TrimSuffix(baseURL, "/"),
+		httpClient: httpClient,
+	}
+}
+
+// Get requests path and decodes the response into output
+func (c *Client) Get(ctx context.Context, path string, output interface{}) error {
+	return c.Do(ctx, http.MethodGet, path, nil, output)
+}
+
+// Post sends input to path and decodes the response into output
+func (c *Client) Post(ctx context.Context, path string, input interface{}, output interface{}) error {
+	return c.Do(ctx, http.MethodPost, path, input, output)
+}
+
+// Patch sends input to path and decodes the response into output
+func (c *Client) Patch(ctx context.Context, path string, input interface{}, output interface{}) error {
+	return c.Do(ctx, http.MethodPatch, path, input, output)
+}
+
+// Delete sends a DELETE request to path
+func (c *Client) Delete(ctx context.Context, path string) error {
+	return c.Do(ctx, http.MethodDelete, path, nil, nil)
+}
+
+// Do sends a request to path, which is either relative to the URL of the API or an absolute URL such as a link in a
+// response. The input is sent as JSON, unless it is a json.RawMessage which is sent as is. The response is decoded
+// into output unless output is nil.
+func (c *Client) Do(ctx context.Context, method string, path string, input interface{}, output interface{}) error {
+	endpoint, err := c.endpoint(path)
+	if err != nil {
+		return err
+	}
+
+	var body io.Reader
+	if input != nil {
+		data, ok := input.(json.RawMessage)
+		if !ok {
+			data, err = json.Marshal(input)
+			if err != nil {
+				return err
+			}
+		}
+		body = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		return newErrorResponse(resp.StatusCode, data)
+	}
+	if output == nil || resp.StatusCode == http.StatusNoContent || len(data) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(data, output); err != nil {
+		return fmt.Errorf("unable to decode the response of %s %s: %w", method, path, err)
+	}
+	return nil
+}
+
+func (c *Client) endpoint(path string) (string, error) {
+	u, err := url.Parse(path)
+	if err != nil {
+		return "", err
+	}
+	if u.IsAbs() {
+		return path, nil
+	}
+	return c.url + path, nil
+}
+
+// newErrorResponse decodes an error of the API. Like the SDK, it handles both {"errors": [...]} and a single error
+// object.
+func newErrorResponse(status int, data []byte) error {
+	result := &content.ErrorResponse{StatusCode: status}
+	if err := json.Unmarshal(data, result); err != nil || len(result.Errors) == 0 {
+		var errorObject content.ErrorObject
+		if err := json.Unmarshal(data, &errorObject); err != nil || errorObject.Message == "" {
+			errorObject.Message = strings.TrimSpace(string(data))
+			if errorObject.Message == "" {
+				errorObject.Message = http.StatusText(status)
+			}
+		}
+		result.Errors = []content.ErrorObject{errorObject}
+	}
+	result.StatusCode = status
+	return result
+}
@@ -0,0 +1,81 @@
+package api_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/labd/amplience-go-sdk/content"
+	"github.com/labd/terraform-provider-amplience/internal/testutils"
+	"github.com/labd/terraform-provider-amplience/internal/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClientDo(t *testing.T) {
+	t.Parallel()
+	ci := testutils.NewClientInfo(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer test-token", r.Header.Get("Authorization"))
+
+		switch r.URL.Path {
+		case "/settings":
+			body, _ := io.ReadAll(r.Body)
+			assert.JSONEq(t, `{"hitsPerPage": 20}`, string(body))
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write(body)
+		case "/empty":
+			w.WriteHeader(http.StatusNoContent)
+		case "/message":
+			testutils.WriteJSON(w, http.StatusBadRequest, map[string]interface{}{"message": "Invalid settings"})
+		default:
+			testutils.NotFound(w, r)
+		}
+	}))
+	ctx := context.Background()
+
+	var settings json.RawMessage
+	require.NoError(t, ci.API.Patch(ctx, "/settings", json.RawMessage(`{"hitsPerPage": 20}`), &settings))
+	assert.JSONEq(t, `{"hitsPerPage": 20}`, string(settings))
+
+	require.NoError(t, ci.API.Delete(ctx, "/empty"))
+
+	err := ci.API.Get(ctx, "/missing", &settings)
+	assert.True(t, utils.IsNotFound(err))
+
+	err = ci.API.Get(ctx, "/message", &settings)
+	var errorResponse *content.ErrorResponse
+	require.ErrorAs(t, err, &errorResponse)
+	assert.Equal(t, http.StatusBadRequest, errorResponse.StatusCode)
+	assert.Equal(t, "Invalid settings", errorResponse.Error())
+}
+
+func TestContentTypeGetAllFailsOnAnyPage(t *testing.T) {
+	t.Parallel()
+	ci := testutils.NewClientInfo(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/hubs/test-hub/content-types", r.URL.Path)
+		assert.Equal(t, "ACTIVE,ARCHIVED", r.URL.Query().Get("status"))
+
+		if r.URL.Query().Get("page") == "1" {
+			testutils.WriteJSON(w, http.StatusInternalServerError, map[string]interface{}{"message": "Internal error"})
+			return
+		}
+		testutils.WriteJSON(w, http.StatusOK, map[string]interface{}{
+			"_embedded": map[string]interface{}{
+				"content-types": []map[string]interface{}{
+					{"id": "5f7d5f7d5f7d5f7d5f7d5f7d", "contentTypeUri": "https://schema.example.com/banner.json"},
+				},
+			},
+			"page": map[string]interface{}{"totalPages": 2},
+		})
+	}))
+
+	_, err := ci.API.ContentTypeGetAll(context.Background(), testutils.HubID, content.StatusAny)
+	var errorResponse *content.ErrorResponse
+	require.ErrorAs(t, err, &errorResponse)
+	assert.Equal(t, http.StatusInternalServerError, errorResponse.StatusCode)
+
+	_, err = ci.API.ContentTypeFindByURI(context.Background(), testutils.HubID, "https://schema.example.com/other.json")
+	require.ErrorAs(t, err, &errorResponse)
+}
@@ -0,0 +1,62 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// ContentItem is a content item as returned by the API. Unlike the SDK, the body is kept as raw JSON and the version
+// is sent on update, so an update fails when the item was changed in the meantime.
+type ContentItem struct {
+	ID                  string          `json:"id"`
+	ContentRepositoryID string          `json:"contentRepositoryId"`
+	FolderID            string          `json:"folderId,omitempty"`
+	Body                json.RawMessage `json:"body"`
+	Version             int             `json:"version"`
+	Label               string          `json:"label"`
+	Locale              string          `json:"locale,omitempty"`
+	Status              string          `json:"status"`
+}
+
+// ContentItemInput is used to create and update a content item. The version is only used on update.
+type ContentItemInput struct {
+	Body     json.RawMessage `json:"body"`
+	Label    string          `json:"label"`
+	FolderID string          `json:"folderId,omitempty"`
+	Locale   string          `json:"locale,omitempty"`
+	Version  int             `json:"version,omitempty"`
+}
+
+type versionInput struct {
+	Version int `json:"version"`
+}
+
+// ContentItemCreate creates a content item in a content repository
+func (c *Client) ContentItemCreate(ctx context.Context, repositoryID string, input ContentItemInput) (ContentItem, error) {
+	var result ContentItem
+	err := c.Post(ctx, fmt.Sprintf("/content-repositories/%s/content-items", repositoryID), input, &result)
+	return result, err
+}
+
+// ContentItemGet returns the content item with the given ID
+func (c *Client) ContentItemGet(ctx context.Context, id string) (ContentItem, error) {
+	var result ContentItem
+	err := c.Get(ctx, fmt.Sprintf("/content-items/%s", id), &result)
+	return result, err
+}
+
+// ContentItemUpdate updates a content item. The API rejects the update with a 409 Conflict when input.Version is not
+// the current version of the item.
+func (c *Client) ContentItemUpdate(ctx context.Context, id string, input ContentItemInput) (ContentItem, error) {
+	var result ContentItem
+	err := c.Patch(ctx, fmt.Sprintf("/content-items/%s", id), input, &result)
+	return result, err
+}
+
+// ContentItemArchive archives a content item. The SDK sends this request to the content type endpoint.
+func (c *Client) ContentItemArchive(ctx context.Context, id string, version int) (ContentItem, error) {
+	var result ContentItem
+	err := c.Post(ctx, fmt.Sprintf("/content-items/%s/archive", id), versionInput{Version: version}, &result)
+	return result, err
+}
@@ -0,0 +1,51 @@
+package api
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/labd/amplience-go-sdk/content"
+)
+
+// ContentRepository is a content repository of a hub. Unlike the SDK type it includes the features and item locales
+// of the repository.
+type ContentRepository struct {
+	ID           string                         `json:"id"`
+	Name         string                         `json:"name"`
+	Label        string                         `json:"label"`
+	Status       string                         `json:"status"`
+	Type         string                         `json:"type"`
+	Features     []string                       `json:"features"`
+	ItemLocales  []string                       `json:"itemLocales"`
+	ContentTypes []content.ContentTypeReference `json:"contentTypes"`
+}
+
+type contentRepositoryResults struct {
+	Embedded struct {
+		ContentRepositories []ContentRepository `json:"content-repositories"`
+	} `json:"_embedded"`
+	Page pageInformation `json:"page"`
+}
+
+// ContentRepositoryGetAll returns all content repositories of a hub. Unlike the SDK, this fails when any of the pages
+// cannot be read.
+func (c *Client) ContentRepositoryGetAll(ctx context.Context, hubID string) ([]ContentRepository, error) {
+	var result []ContentRepository
+	for page := 0; ; page++ {
+		var response contentRepositoryResults
+		err := c.Get(ctx, fmt.Sprintf("/hubs/%s/content-repositories?%s", hubID, pageQuery(page)), &response)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, response.Embedded.ContentRepositories...)
+		if page >= response.Page.TotalPages-1 {
+			return result, nil
+		}
+	}
+}
+
+// ContentRepositoryHubID returns the ID of the hub of a content repository, or an empty string when the repository
+// does not link to its hub
+func ContentRepositoryHubID(repository content.ContentRepository) string {
+	return linkedID(repository.Links, "hub")
+}
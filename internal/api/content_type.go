@@ -0,0 +1,112 @@
+package api
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/labd/amplience-go-sdk/content"
+	"github.com/labd/terraform-provider-amplience/internal/utils"
+)
+
+// ContentTypeGetAll returns the content types of a hub with the given status, or all content types for
+// content.StatusAny. Unlike the SDK, this fails when any of the pages cannot be read.
+func (c *Client) ContentTypeGetAll(ctx context.Context, hubID string, status content.ContentStatus) ([]content.ContentType, error) {
+	var result []content.ContentType
+	for page := 0; ; page++ {
+		query := content.ContentTypePaginationQueryString(content.StatusPaginationParameters{
+			Page:   page,
+			Size:   listPageSize,
+			Status: status,
+		})
+
+		var response content.ContentTypeResults
+		err := c.Get(ctx, fmt.Sprintf("/hubs/%s/content-types?%s", hubID, query), &response)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, response.Items...)
+		if page >= response.Page.TotalPages-1 {
+			return result, nil
+		}
+	}
+}
+
+// ContentTypeFindByURI returns the content type of a hub with the given URI, whether it is active or archived
+func (c *Client) ContentTypeFindByURI(ctx context.Context, hubID string, uri string) (content.ContentType, error) {
+	contentTypes, err := c.ContentTypeGetAll(ctx, hubID, content.StatusAny)
+	if err != nil {
+		return content.ContentType{}, err
+	}
+
+	return utils.FindUnique(contentTypes,
+		func(c content.ContentType) string { return c.ContentTypeURI },
+		func(c content.ContentType) string { return c.ID },
+		uri, "content type", "content_type_uri")
+}
+
+// ContentTypeFindActiveByURI returns the active content type of a hub with the given URI, for importing it. An
+// archived content type with the URI is reported as an error.
+func (c *Client) ContentTypeFindActiveByURI(ctx context.Context, hubID string, uri string) (content.ContentType, error) {
+	contentTypes, err := c.ContentTypeGetAll(ctx, hubID, content.StatusAny)
+	if err != nil {
+		return content.ContentType{}, err
+	}
+
+	return utils.FindActive(contentTypes,
+		func(c content.ContentType) string { return c.ContentTypeURI },
+		func(c content.ContentType) string { return c.ID },
+		func(c content.ContentType) string { return c.Status },
+		uri, "content type", "content_type_uri")
+}
+
+// ContentTypeSchemaGetAll returns the content type schemas of a hub with the given status, or all schemas for
+// content.StatusAny. Unlike the SDK, this fails when any of the pages cannot be read.
+func (c *Client) ContentTypeSchemaGetAll(ctx context.Context, hubID string, status content.ContentStatus) ([]content.ContentTypeSchema, error) {
+	var result []content.ContentTypeSchema
+	for page := 0; ; page++ {
+		query := content.ContentTypeSchemaPaginationQueryString(content.StatusPaginationParameters{
+			Page:   page,
+			Size:   listPageSize,
+			Status: status,
+		})
+
+		var response content.ContentTypeSchemaResults
+		err := c.Get(ctx, fmt.Sprintf("/hubs/%s/content-type-schemas?%s", hubID, query), &response)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, response.Items...)
+		if page >= response.Page.TotalPages-1 {
+			return result, nil
+		}
+	}
+}
+
+// ContentTypeSchemaFindBySchemaID returns the content type schema of a hub with the given schema ID, whether it is
+// active or archived
+func (c *Client) ContentTypeSchemaFindBySchemaID(ctx context.Context, hubID string, schemaID string) (content.ContentTypeSchema, error) {
+	schemas, err := c.ContentTypeSchemaGetAll(ctx, hubID, content.StatusAny)
+	if err != nil {
+		return content.ContentTypeSchema{}, err
+	}
+
+	return utils.FindUnique(schemas,
+		func(s content.ContentTypeSchema) string { return s.SchemaID },
+		func(s content.ContentTypeSchema) string { return s.ID },
+		schemaID, "content type schema", "schema_id")
+}
+
+// ContentTypeSchemaFindActiveBySchemaID returns the active content type schema of a hub with the given schema ID, for
+// importing it. An archived schema with the schema ID is reported as an error.
+func (c *Client) ContentTypeSchemaFindActiveBySchemaID(ctx context.Context, hubID string, schemaID string) (content.ContentTypeSchema, error) {
+	schemas, err := c.ContentTypeSchemaGetAll(ctx, hubID, content.StatusAny)
+	if err != nil {
+		return content.ContentTypeSchema{}, err
+	}
+
+	return utils.FindActive(schemas,
+		func(s content.ContentTypeSchema) string { return s.SchemaID },
+		func(s content.ContentTypeSchema) string { return s.ID },
+		func(s content.ContentTypeSchema) string { return s.Status },
+		schemaID, "content type schema", "schema_id")
+}
@@ -0,0 +1,94 @@
+package api
+
+import (
+	"context"
+	"fmt"
+)
+
+// Publishing statuses of an edition
+const (
+	EditionStatusDraft        = "DRAFT"
+	EditionStatusScheduling   = "SCHEDULING"
+	EditionStatusScheduled    = "SCHEDULED"
+	EditionStatusPublishing   = "PUBLISHING"
+	EditionStatusPublished    = "PUBLISHED"
+	EditionStatusUnscheduling = "UNSCHEDULING"
+)
+
+// Event is a planned campaign, such as Black Friday, which contains editions. Start and End are RFC3339 timestamps.
+type Event struct {
+	ID      string `json:"id,omitempty"`
+	Name    string `json:"name"`
+	Start   string `json:"start"`
+	End     string `json:"end"`
+	Brief   string `json:"brief"`
+	Comment string `json:"comment"`
+}
+
+// Edition is a set of content that is published during (a part of) an event. Start and End are RFC3339 timestamps.
+type Edition struct {
+	ID               string `json:"id,omitempty"`
+	EventID          string `json:"eventId,omitempty"`
+	Name             string `json:"name"`
+	Start            string `json:"start"`
+	End              string `json:"end"`
+	ActiveEndDate    bool   `json:"activeEndDate"`
+	PublishingStatus string `json:"publishingStatus,omitempty"`
+}
+
+// IsLocked returns whether the edition is scheduled or published, after which it can no longer be changed
+func (e *Edition) IsLocked() bool {
+	return e.PublishingStatus != "" && e.PublishingStatus != EditionStatusDraft
+}
+
+// EventCreate creates an event on a hub
+func (c *Client) EventCreate(ctx context.Context, hubID string, input Event) (Event, error) {
+	var result Event
+	err := c.Post(ctx, fmt.Sprintf("/hubs/%s/events", hubID), input, &result)
+	return result, err
+}
+
+// EventGet returns the event with the given ID
+func (c *Client) EventGet(ctx context.Context, id string) (Event, error) {
+	var result Event
+	err := c.Get(ctx, fmt.Sprintf("/events/%s", id), &result)
+	return result, err
+}
+
+// EventUpdate updates an event
+func (c *Client) EventUpdate(ctx context.Context, id string, input Event) (Event, error) {
+	var result Event
+	err := c.Patch(ctx, fmt.Sprintf("/events/%s", id), input, &result)
+	return result, err
+}
+
+// EventDelete deletes an event. The API rejects this when the event has scheduled or published editions.
+func (c *Client) EventDelete(ctx context.Context, id string) error {
+	return c.Delete(ctx, fmt.Sprintf("/events/%s", id))
+}
+
+// EditionCreate creates an edition in an event
+func (c *Client) EditionCreate(ctx context.Context, eventID string, input Edition) (Edition, error) {
+	var result Edition
+	err := c.Post(ctx, fmt.Sprintf("/events/%s/editions", eventID), input, &result)
+	return result, err
+}
+
+// EditionGet returns the edition with the given ID
+func (c *Client) EditionGet(ctx context.Context, id string) (Edition, error) {
+	var result Edition
+	err := c.Get(ctx, fmt.Sprintf("/editions/%s", id), &result)
+	return result, err
+}
+
+// EditionUpdate updates an edition. Only draft editions can be updated.
+func (c *Client) EditionUpdate(ctx context.Context, id string, input Edition) (Edition, error) {
+	var result Edition
+	err := c.Patch(ctx, fmt.Sprintf("/editions/%s", id), input, &result)
+	return result, err
+}
+
+// EditionDelete deletes an edition. Only draft editions can be deleted.
+func (c *Client) EditionDelete(ctx context.Context, id string) error {
+	return c.Delete(ctx, fmt.Sprintf("/editions/%s", id))
+}
@@ -0,0 +1,69 @@
+package api
+
+import (
+	"context"
+	"fmt"
+)
+
+// Extension categories
+const (
+	ExtensionCategoryContentField  = "CONTENT_FIELD"
+	ExtensionCategoryDashboard     = "DASHBOARD"
+	ExtensionCategoryContentEditor = "CONTENT_EDITOR"
+)
+
+// Extension is a UI extension registered on a hub. The API stores both the installation parameters and the settings
+// as JSON encoded strings.
+type Extension struct {
+	ID                        string             `json:"id,omitempty"`
+	Name                      string             `json:"name"`
+	Label                     string             `json:"label"`
+	URL                       string             `json:"url"`
+	Category                  string             `json:"category"`
+	Height                    int                `json:"height,omitempty"`
+	EnabledForAllContentTypes bool               `json:"enabledForAllContentTypes"`
+	Parameters                string             `json:"parameters,omitempty"`
+	Snippets                  []ExtensionSnippet `json:"snippets"`
+	Settings                  string             `json:"settings,omitempty"`
+	Status                    string             `json:"status,omitempty"`
+}
+
+// ExtensionSnippet is an initial value an editor can choose for a content field extension. The body is a JSON value.
+type ExtensionSnippet struct {
+	Label string `json:"label"`
+	Body  string `json:"body"`
+}
+
+// ExtensionSettings are the permissions of an extension, stored in Extension.Settings. API contains the permissions
+// to use the Dynamic Content APIs, such as READ and EDIT; Sandbox the iframe sandbox permissions, such as
+// SAME_ORIGIN and MODALS.
+type ExtensionSettings struct {
+	API     map[string]bool `json:"API,omitempty"`
+	Sandbox map[string]bool `json:"SANDBOX,omitempty"`
+}
+
+// ExtensionCreate registers an extension on a hub
+func (c *Client) ExtensionCreate(ctx context.Context, hubID string, input Extension) (Extension, error) {
+	var result Extension
+	err := c.Post(ctx, fmt.Sprintf("/hubs/%s/extensions", hubID), input, &result)
+	return result, err
+}
+
+// ExtensionGet returns the extension with the given ID
+func (c *Client) ExtensionGet(ctx context.Context, id string) (Extension, error) {
+	var result Extension
+	err := c.Get(ctx, fmt.Sprintf("/extensions/%s", id), &result)
+	return result, err
+}
+
+// ExtensionUpdate replaces the configuration of an extension
+func (c *Client) ExtensionUpdate(ctx context.Context, id string, input Extension) (Extension, error) {
+	var result Extension
+	err := c.Patch(ctx, fmt.Sprintf("/extensions/%s", id), input, &result)
+	return result, err
+}
+
+// ExtensionDelete deletes an extension
+func (c *Client) ExtensionDelete(ctx context.Context, id string) error {
+	return c.Delete(ctx, fmt.Sprintf("/extensions/%s", id))
+}
@@ -0,0 +1,67 @@
+package api
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/labd/amplience-go-sdk/content"
+)
+
+// Folder is a folder in a content repository. The SDK does not return the ID of a created folder and cannot create
+// subfolders or rename folders.
+type Folder struct {
+	ID    string                  `json:"id"`
+	Name  string                  `json:"name"`
+	Links map[string]content.Link `json:"_links,omitempty"`
+}
+
+// ParentFolderID returns the ID of the parent folder, or an empty string for a folder in the root of the repository
+func (f *Folder) ParentFolderID() string {
+	return f.linkedID("parent-folder")
+}
+
+// RepositoryID returns the ID of the content repository of the folder
+func (f *Folder) RepositoryID() string {
+	return f.linkedID("content-repository")
+}
+
+func (f *Folder) linkedID(rel string) string {
+	return linkedID(f.Links, rel)
+}
+
+// FolderInput is used to create and rename a folder
+type FolderInput struct {
+	Name string `json:"name"`
+}
+
+// FolderCreate creates a folder in the root of a content repository, or in the folder parentFolderID when it is not
+// empty
+func (c *Client) FolderCreate(ctx context.Context, repositoryID string, parentFolderID string, input FolderInput) (Folder, error) {
+	endpoint := fmt.Sprintf("/content-repositories/%s/folders", repositoryID)
+	if parentFolderID != "" {
+		endpoint = fmt.Sprintf("/folders/%s/folders", parentFolderID)
+	}
+
+	var result Folder
+	err := c.Post(ctx, endpoint, input, &result)
+	return result, err
+}
+
+// FolderGet returns the folder with the given ID
+func (c *Client) FolderGet(ctx context.Context, id string) (Folder, error) {
+	var result Folder
+	err := c.Get(ctx, fmt.Sprintf("/folders/%s", id), &result)
+	return result, err
+}
+
+// FolderUpdate renames a folder
+func (c *Client) FolderUpdate(ctx context.Context, id string, input FolderInput) (Folder, error) {
+	var result Folder
+	err := c.Patch(ctx, fmt.Sprintf("/folders/%s", id), input, &result)
+	return result, err
+}
+
+// FolderDelete deletes a folder
+func (c *Client) FolderDelete(ctx context.Context, id string) error {
+	return c.Delete(ctx, fmt.Sprintf("/folders/%s", id))
+}
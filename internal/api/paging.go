@@ -0,0 +1,36 @@
+package api
+
+import (
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/labd/amplience-go-sdk/content"
+)
+
+// pageInformation is the page of a list response, which is used to request the following pages
+type pageInformation struct {
+	TotalPages int `json:"totalPages"`
+}
+
+// listPageSize is the number of items requested per page when listing all items
+const listPageSize = 100
+
+func pageQuery(page int) string {
+	query := url.Values{}
+	query.Set("page", strconv.Itoa(page))
+	query.Set("size", strconv.Itoa(listPageSize))
+	return query.Encode()
+}
+
+// linkedID returns the ID of the object the link rel points to, or an empty string when there is no such link
+func linkedID(links map[string]content.Link, rel string) string {
+	link, ok := links[rel]
+	if !ok || link.Href == "" {
+		return ""
+	}
+	// Links can be URI templates, such as .../folders/abc{?projection}
+	href := strings.SplitN(strings.SplitN(link.Href, "{", 2)[0], "?", 2)[0]
+	return path.Base(href)
+}
@@ -0,0 +1,38 @@
+package api
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/labd/amplience-go-sdk/content"
+)
+
+type webhookResults struct {
+	Embedded struct {
+		Webhooks []content.Webhook `json:"webhooks"`
+	} `json:"_embedded"`
+	Page pageInformation `json:"page"`
+}
+
+// WebhookGet returns the webhook of a hub with the given ID
+func (c *Client) WebhookGet(ctx context.Context, hubID string, id string) (content.Webhook, error) {
+	var result content.Webhook
+	err := c.Get(ctx, fmt.Sprintf("/hubs/%s/webhooks/%s", hubID, id), &result)
+	return result, err
+}
+
+// WebhookGetAll returns all webhooks of a hub. Unlike the SDK, this fails when any of the pages cannot be read.
+func (c *Client) WebhookGetAll(ctx context.Context, hubID string) ([]content.Webhook, error) {
+	var result []content.Webhook
+	for page := 0; ; page++ {
+		var response webhookResults
+		err := c.Get(ctx, fmt.Sprintf("/hubs/%s/webhooks?%s", hubID, pageQuery(page)), &response)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, response.Embedded.Webhooks...)
+		if page >= response.Page.TotalPages-1 {
+			return result, nil
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package api
+
+import (
+	"context"
+	"fmt"
+)
+
+// WorkflowState is an editorial workflow state of a hub, such as "In review". The SDK does not support workflow
+// states.
+type WorkflowState struct {
+	ID    string `json:"id,omitempty"`
+	Label string `json:"label"`
+	Color string `json:"color"`
+}
+
+type workflowStateResults struct {
+	Embedded struct {
+		WorkflowStates []WorkflowState `json:"workflow-states"`
+	} `json:"_embedded"`
+	Page pageInformation `json:"page"`
+}
+
+// WorkflowStateCreate creates a workflow state on a hub
+func (c *Client) WorkflowStateCreate(ctx context.Context, hubID string, input WorkflowState) (WorkflowState, error) {
+	var result WorkflowState
+	err := c.Post(ctx, fmt.Sprintf("/hubs/%s/workflow-states", hubID), input, &result)
+	return result, err
+}
+
+// WorkflowStateGet returns the workflow state with the given ID
+func (c *Client) WorkflowStateGet(ctx context.Context, id string) (WorkflowState, error) {
+	var result WorkflowState
+	err := c.Get(ctx, fmt.Sprintf("/workflow-states/%s", id), &result)
+	return result, err
+}
+
+// WorkflowStateUpdate updates the label and color of a workflow state
+func (c *Client) WorkflowStateUpdate(ctx context.Context, id string, input WorkflowState) (WorkflowState, error) {
+	var result WorkflowState
+	err := c.Patch(ctx, fmt.Sprintf("/workflow-states/%s", id), input, &result)
+	return result, err
+}
+
+// WorkflowStateGetAll returns all workflow states of a hub
+func (c *Client) WorkflowStateGetAll(ctx context.Context, hubID string) ([]WorkflowState, error) {
+	var result []WorkflowState
+	for page := 0; ; page++ {
+		var response workflowStateResults
+		err := c.Get(ctx, fmt.Sprintf("/hubs/%s/workflow-states?%s", hubID, pageQuery(page)), &response)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, response.Embedded.WorkflowStates...)
+		if page >= response.Page.TotalPages-1 {
+			return result, nil
+		}
+	}
+}
@@ -0,0 +1,96 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/labd/amplience-go-sdk/content"
+	"github.com/labd/terraform-provider-amplience/internal/api"
+	"github.com/labd/terraform-provider-amplience/internal/utils"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+)
+
+// ClientInfo is passed to all resources and data sources as provider data
+type ClientInfo struct {
+	Client *content.Client
+	// API is used for the endpoints that are not available in the Amplience SDK
+	API   *api.Client
+	HubID string
+}
+
+var (
+	clientsMu sync.Mutex
+	clients   = map[Config]*ClientInfo{}
+)
+
+// NewClientInfo returns the ClientInfo for the given configuration. Both halves of the mux server are configured with
+// the same configuration, so the clients (and thereby the OAuth token) created for the first half are reused by the
+// second.
+func NewClientInfo(cfg *Config, version string) (*ClientInfo, error) {
+	clientsMu.Lock()
+	defer clientsMu.Unlock()
+
+	if ci, ok := clients[*cfg]; ok {
+		return ci, nil
+	}
+
+	httpClient := utils.NewHTTPClient(
+		fmt.Sprintf("terraform-provider-amplience/%s", version),
+		cfg.MaxRetries,
+		cfg.RetryMaxWait,
+	)
+
+	ci, err := NewClientInfoFromHTTPClient(cfg, httpClient)
+	if err != nil {
+		return nil, err
+	}
+	clients[*cfg] = ci
+	return ci, nil
+}
+
+// NewClientInfoFromHTTPClient creates the clients for cfg that send their requests with httpClient. The SDK client and
+// the API client share a single OAuth token, which is fetched and refreshed with httpClient as well.
+func NewClientInfoFromHTTPClient(cfg *Config, httpClient *http.Client) (*ClientInfo, error) {
+	auth := &clientcredentials.Config{
+		ClientID:     cfg.ClientID,
+		ClientSecret: cfg.ClientSecret,
+		TokenURL:     cfg.AuthURL,
+	}
+	tokenSource := oauth2.ReuseTokenSource(nil, auth.TokenSource(context.WithValue(context.Background(), oauth2.HTTPClient, httpClient)))
+
+	transport := httpClient.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	client, err := content.NewClient(&content.ClientConfig{
+		ClientID:     cfg.ClientID,
+		ClientSecret: cfg.ClientSecret,
+		URL:          cfg.ContentAPIURL,
+		AuthURL:      cfg.AuthURL,
+		HTTPClient: &http.Client{
+			Transport: &utils.TokenSourceTransport{
+				TokenURL:  cfg.AuthURL,
+				Source:    tokenSource,
+				Transport: transport,
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &ClientInfo{
+		Client: client,
+		API: api.NewClient(cfg.ContentAPIURL, &http.Client{
+			Transport: &oauth2.Transport{
+				Source: tokenSource,
+				Base:   transport,
+			},
+		}),
+		HubID: cfg.HubID,
+	}, nil
+}
@@ -0,0 +1,244 @@
+package config
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/labd/terraform-provider-amplience/internal/utils"
+)
+
+const (
+	DefaultContentAPIURL = "https://api.amplience.net/v2/content"
+	DefaultAuthURL       = "https://auth.amplience.net/oauth/token"
+)
+
+type AttributeType int
+
+const (
+	TypeString AttributeType = iota
+	TypeInt
+)
+
+// Attribute describes a single provider configuration attribute. Both halves of the mux server build their provider
+// schema from Attributes, since the mux server requires them to be identical.
+type Attribute struct {
+	Name        string
+	Description string
+	Type        AttributeType
+	Sensitive   bool
+	EnvVar      string
+}
+
+var Attributes = []Attribute{
+	{
+		Name:        "client_id",
+		Description: "The OAuth Client ID for the Amplience management API https://amplience_provider.com/docs/api/dynamic-content/management/index.html#section/Authentication",
+		Type:        TypeString,
+		Sensitive:   true,
+		EnvVar:      "AMPLIENCE_CLIENT_ID",
+	},
+	{
+		Name:        "client_secret",
+		Description: "The OAuth Client Secret for Amplience management API. https://amplience_provider.com/docs/api/dynamic-content/management/index.html#section/Authentication",
+		Type:        TypeString,
+		Sensitive:   true,
+		EnvVar:      "AMPLIENCE_CLIENT_SECRET",
+	},
+	{
+		Name:        "content_api_url",
+		Description: fmt.Sprintf("The base URL path for the Amplience Content API. Defaults to %s", DefaultContentAPIURL),
+		Type:        TypeString,
+		EnvVar:      "AMPLIENCE_CONTENT_API_URL",
+	},
+	{
+		Name:        "auth_url",
+		Description: fmt.Sprintf("The Amplience authentication URL. Defaults to %s", DefaultAuthURL),
+		Type:        TypeString,
+		EnvVar:      "AMPLIENCE_AUTH_URL",
+	},
+	{
+		Name:        "hub_id",
+		Description: "ID of the Hub to manage. Optional when every resource and data source sets its own `hub_id`",
+		Type:        TypeString,
+		EnvVar:      "AMPLIENCE_HUB_ID",
+	},
+	{
+		Name:        "max_retries",
+		Description: fmt.Sprintf("Maximum number of times a request to the Amplience API is retried when it is rate limited or fails with a server error. Defaults to %d", utils.DefaultMaxRetries),
+		Type:        TypeInt,
+		EnvVar:      "AMPLIENCE_MAX_RETRIES",
+	},
+	{
+		Name:        "retry_max_wait",
+		Description: fmt.Sprintf("Maximum number of seconds to wait between two retries of a request to the Amplience API. Defaults to %d", int(utils.DefaultRetryMaxWait.Seconds())),
+		Type:        TypeInt,
+		EnvVar:      "AMPLIENCE_RETRY_MAX_WAIT",
+	},
+}
+
+// FullDescription returns the description of the attribute including the environment variable it can be read from
+func (a Attribute) FullDescription() string {
+	return fmt.Sprintf("%s. Can also be set with the `%s` environment variable", strings.TrimSuffix(a.Description, "."), a.EnvVar)
+}
+
+// RawConfig is the provider configuration as set in Terraform. A nil value means the attribute is not set, in which
+// case the environment variable or default is used.
+type RawConfig struct {
+	ClientID      *string
+	ClientSecret  *string
+	ContentAPIURL *string
+	AuthURL       *string
+	HubID         *string
+	MaxRetries    *int64
+	RetryMaxWait  *int64
+}
+
+// Config is the resolved and validated provider configuration
+type Config struct {
+	ClientID      string
+	ClientSecret  string
+	ContentAPIURL string
+	AuthURL       string
+	HubID         string
+	MaxRetries    int
+	RetryMaxWait  time.Duration
+}
+
+// AttributeError is a validation error for a single provider attribute
+type AttributeError struct {
+	Attribute string
+	Summary   string
+	Detail    string
+}
+
+// Resolve applies the environment variable fallbacks and defaults to the raw configuration and validates the result.
+func Resolve(raw RawConfig) (*Config, []AttributeError) {
+	var errs []AttributeError
+
+	cfg := &Config{
+		ClientID:      resolveString(raw.ClientID, "client_id", ""),
+		ClientSecret:  resolveString(raw.ClientSecret, "client_secret", ""),
+		ContentAPIURL: resolveString(raw.ContentAPIURL, "content_api_url", DefaultContentAPIURL),
+		AuthURL:       resolveString(raw.AuthURL, "auth_url", DefaultAuthURL),
+		HubID:         resolveString(raw.HubID, "hub_id", ""),
+	}
+
+	maxRetries, err := resolveInt(raw.MaxRetries, "max_retries", utils.DefaultMaxRetries)
+	if err != nil {
+		errs = append(errs, *err)
+	}
+	cfg.MaxRetries = int(maxRetries)
+
+	retryMaxWait, err := resolveInt(raw.RetryMaxWait, "retry_max_wait", int64(utils.DefaultRetryMaxWait.Seconds()))
+	if err != nil {
+		errs = append(errs, *err)
+	}
+	cfg.RetryMaxWait = time.Duration(retryMaxWait) * time.Second
+
+	return cfg, append(errs, cfg.validate()...)
+}
+
+func (c *Config) validate() []AttributeError {
+	var errs []AttributeError
+
+	if c.ClientID == "" {
+		errs = append(errs, AttributeError{
+			Attribute: "client_id",
+			Summary:   "Unknown Amplience Client ID",
+			Detail:    "Unknown Amplience Client ID. Please provide a valid client ID.",
+		})
+	}
+
+	if c.ClientSecret == "" {
+		errs = append(errs, AttributeError{
+			Attribute: "client_secret",
+			Summary:   "Unknown Amplience Client Secret",
+			Detail:    "Unknown Amplience Client Secret. Please provide a valid client secret",
+		})
+	}
+
+	if strings.Contains(c.HubID, " ") {
+		errs = append(errs, AttributeError{
+			Attribute: "hub_id",
+			Summary:   "Invalid Amplience Hub ID",
+			Detail:    "The Amplience Hub ID should not contain any whitespace.",
+		})
+	}
+
+	urls := []struct{ attribute, value string }{
+		{"content_api_url", c.ContentAPIURL},
+		{"auth_url", c.AuthURL},
+	}
+	for _, item := range urls {
+		if u, err := url.Parse(item.value); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			errs = append(errs, AttributeError{
+				Attribute: item.attribute,
+				Summary:   "Invalid URL",
+				Detail:    fmt.Sprintf("%q is not a valid http(s) URL.", item.value),
+			})
+		}
+	}
+
+	if c.MaxRetries < 0 {
+		errs = append(errs, AttributeError{
+			Attribute: "max_retries",
+			Summary:   "Invalid max_retries",
+			Detail:    "The number of retries should be 0 or more.",
+		})
+	}
+
+	if c.RetryMaxWait < time.Second {
+		errs = append(errs, AttributeError{
+			Attribute: "retry_max_wait",
+			Summary:   "Invalid retry_max_wait",
+			Detail:    "The maximum wait between retries should be at least 1 second.",
+		})
+	}
+
+	return errs
+}
+
+func resolveString(value *string, name string, fallback string) string {
+	if value != nil {
+		return *value
+	}
+	if env := os.Getenv(attribute(name).EnvVar); env != "" {
+		return env
+	}
+	return fallback
+}
+
+func resolveInt(value *int64, name string, fallback int64) (int64, *AttributeError) {
+	if value != nil {
+		return *value, nil
+	}
+
+	envVar := attribute(name).EnvVar
+	env := os.Getenv(envVar)
+	if env == "" {
+		return fallback, nil
+	}
+
+	result, err := strconv.ParseInt(env, 10, 64)
+	if err != nil {
+		return fallback, &AttributeError{
+			Attribute: name,
+			Summary:   fmt.Sprintf("Invalid %s", name),
+			Detail:    fmt.Sprintf("The %s environment variable should be a number, got %q.", envVar, env),
+		}
+	}
+	return result, nil
+}
+
+func attribute(name string) Attribute {
+	for _, a := range Attributes {
+		if a.Name == name {
+			return a
+		}
+	}
+	panic(fmt.Sprintf("unknown provider attribute %s", name))
+}
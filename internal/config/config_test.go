@@ -0,0 +1,159 @@
+package config
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func clearEnv(t *testing.T) {
+	for _, attribute := range Attributes {
+		t.Setenv(attribute.EnvVar, "")
+	}
+}
+
+func TestResolve(t *testing.T) {
+	clearEnv(t)
+	t.Setenv("AMPLIENCE_CLIENT_SECRET", "env-secret")
+	t.Setenv("AMPLIENCE_MAX_RETRIES", "5")
+
+	clientID := "client-id"
+	hubID := "hub-id"
+	retryMaxWait := int64(10)
+
+	cfg, errs := Resolve(RawConfig{
+		ClientID:     &clientID,
+		HubID:        &hubID,
+		RetryMaxWait: &retryMaxWait,
+	})
+
+	assert.Empty(t, errs)
+	assert.Equal(t, &Config{
+		ClientID:      "client-id",
+		ClientSecret:  "env-secret",
+		ContentAPIURL: DefaultContentAPIURL,
+		AuthURL:       DefaultAuthURL,
+		HubID:         "hub-id",
+		MaxRetries:    5,
+		RetryMaxWait:  10 * time.Second,
+	}, cfg)
+}
+
+func TestResolveConfigTakesPrecedence(t *testing.T) {
+	clearEnv(t)
+	t.Setenv("AMPLIENCE_HUB_ID", "env-hub")
+	t.Setenv("AMPLIENCE_MAX_RETRIES", "5")
+
+	hubID := "config-hub"
+	maxRetries := int64(0)
+
+	cfg, _ := Resolve(RawConfig{
+		HubID:      &hubID,
+		MaxRetries: &maxRetries,
+	})
+
+	assert.Equal(t, "config-hub", cfg.HubID)
+	assert.Equal(t, 0, cfg.MaxRetries)
+}
+
+func TestResolveWithoutHubID(t *testing.T) {
+	clearEnv(t)
+
+	clientID := "client-id"
+	clientSecret := "client-secret"
+
+	cfg, errs := Resolve(RawConfig{
+		ClientID:     &clientID,
+		ClientSecret: &clientSecret,
+	})
+
+	assert.Empty(t, errs)
+	assert.Equal(t, "", cfg.HubID)
+}
+
+func TestResolveValidation(t *testing.T) {
+	clearEnv(t)
+	t.Setenv("AMPLIENCE_RETRY_MAX_WAIT", "soon")
+
+	hubID := "my hub"
+	authURL := "auth.amplience.net/oauth/token"
+	maxRetries := int64(-1)
+
+	_, errs := Resolve(RawConfig{
+		HubID:      &hubID,
+		AuthURL:    &authURL,
+		MaxRetries: &maxRetries,
+	})
+
+	var attributes []string
+	for _, err := range errs {
+		attributes = append(attributes, err.Attribute)
+	}
+	assert.Equal(t, []string{"retry_max_wait", "client_id", "client_secret", "hub_id", "auth_url", "max_retries"}, attributes)
+}
+
+func TestNewClientInfoIsShared(t *testing.T) {
+	cfg := &Config{
+		ClientID:      "client-id",
+		ClientSecret:  "client-secret",
+		ContentAPIURL: DefaultContentAPIURL,
+		AuthURL:       DefaultAuthURL,
+		HubID:         "hub-id",
+		RetryMaxWait:  time.Second,
+	}
+
+	first, err := NewClientInfo(cfg, "testing")
+	assert.NoError(t, err)
+
+	second, err := NewClientInfo(cfg, "testing")
+	assert.NoError(t, err)
+	assert.Same(t, first, second)
+
+	other := *cfg
+	other.HubID = "other-hub"
+	third, err := NewClientInfo(&other, "testing")
+	assert.NoError(t, err)
+	assert.NotSame(t, first, third)
+}
+
+func TestNewClientInfoSharesToken(t *testing.T) {
+	var tokenRequests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/oauth/token":
+			atomic.AddInt32(&tokenRequests, 1)
+			_, _ = w.Write([]byte(`{"access_token": "test-token", "token_type": "bearer", "expires_in": 3600}`))
+		case "/hubs/hub-id":
+			assert.Equal(t, "Bearer test-token", r.Header.Get("Authorization"))
+			_, _ = w.Write([]byte(`{"id": "hub-id", "name": "hub"}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	ci, err := NewClientInfoFromHTTPClient(&Config{
+		ClientID:      "client-id",
+		ClientSecret:  "client-secret",
+		ContentAPIURL: server.URL,
+		AuthURL:       server.URL + "/oauth/token",
+		HubID:         "hub-id",
+	}, server.Client())
+	require.NoError(t, err)
+
+	_, err = ci.Client.HubGet("hub-id")
+	require.NoError(t, err)
+
+	var hub map[string]interface{}
+	require.NoError(t, ci.API.Get(context.Background(), "/hubs/hub-id", &hub))
+	assert.Equal(t, "hub", hub["name"])
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(&tokenRequests))
+}
@@ -0,0 +1,142 @@
+package content_repositories
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/labd/terraform-provider-amplience/internal/api"
+	"github.com/labd/terraform-provider-amplience/internal/config"
+	"github.com/labd/terraform-provider-amplience/internal/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &contentRepositoriesDataSource{}
+	_ datasource.DataSourceWithConfigure = &contentRepositoriesDataSource{}
+)
+
+// NewContentRepositoriesDataSource is a helper function to simplify the provider implementation.
+func NewContentRepositoriesDataSource() datasource.DataSource {
+	return &contentRepositoriesDataSource{}
+}
+
+// contentRepositoriesDataSource is the data source implementation.
+type contentRepositoriesDataSource struct {
+	api   *api.Client
+	hubId string
+}
+
+// Metadata returns the data source type name.
+func (d *contentRepositoriesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_content_repositories"
+}
+
+// Schema defines the schema for the data source.
+func (d *contentRepositoriesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "All content repositories of a hub. Use `ids` to refer to a content repository by its " +
+			"name.",
+		Attributes: map[string]schema.Attribute{
+			"hub_id": schema.StringAttribute{
+				Description: "ID of the Hub to read the content repositories of. Defaults to the `hub_id` of the " +
+					"provider",
+				Optional:   true,
+				Computed:   true,
+				Validators: []validator.String{utils.NoWhitespace()},
+			},
+			"content_repositories": schema.ListNestedAttribute{
+				Description: "The content repositories of the hub",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "ID of the content repository",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Name of the content repository",
+							Computed:    true,
+						},
+						"label": schema.StringAttribute{
+							Description: "Label of the content repository",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "Status of the content repository, such as `ACTIVE`",
+							Computed:    true,
+						},
+						"features": schema.ListAttribute{
+							Description: "Features enabled on the content repository, such as `slots`",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"item_locales": schema.ListAttribute{
+							Description: "Locales of the content items in the content repository",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"content_types": schema.ListNestedAttribute{
+							Description: "Content types assigned to the content repository",
+							Computed:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"id": schema.StringAttribute{
+										Description: "ID of the content type",
+										Computed:    true,
+									},
+									"content_type_uri": schema.StringAttribute{
+										Description: "URI of the schema of the content type",
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"ids": schema.MapAttribute{
+				Description: "The IDs of the content repositories by name",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *contentRepositoriesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	data := req.ProviderData.(*config.ClientInfo)
+	d.api = data.API
+	d.hubId = data.HubID
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *contentRepositoriesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config ContentRepositories
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hubID, err := utils.RequireHubID(config.HubID, d.hubId)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("hub_id"), "Missing hub ID", err.Error())
+		return
+	}
+	repositories, err := d.api.ContentRepositoryGetAll(ctx, hubID)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read content repositories", utils.ErrorDetail(err))
+		return
+	}
+
+	diags = resp.State.Set(ctx, NewContentRepositoriesFromNative(hubID, repositories))
+	resp.Diagnostics.Append(diags...)
+}
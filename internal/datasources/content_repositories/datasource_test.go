@@ -0,0 +1,86 @@
+package content_repositories
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/labd/terraform-provider-amplience/internal/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContentRepositoriesDataSourceRead(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	pages := [][]map[string]interface{}{
+		{
+			{
+				"id":          "content-id",
+				"name":        "content",
+				"label":       "Content",
+				"status":      "ACTIVE",
+				"features":    []string{},
+				"itemLocales": []string{"en-GB", "nl-NL"},
+				"contentTypes": []map[string]interface{}{
+					{"hubContentTypeId": "banner-id", "contentTypeUri": "https://schema.example.com/banner.json"},
+				},
+			},
+		},
+		{
+			{"id": "slots-id", "name": "slots", "label": "Slots", "status": "ACTIVE", "features": []string{"slots"}},
+		},
+	}
+	ci := testutils.NewClientInfo(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/hubs/test-hub/content-repositories", r.URL.Path)
+		page := 0
+		if r.URL.Query().Get("page") == "1" {
+			page = 1
+		}
+		testutils.WriteJSON(w, http.StatusOK, map[string]interface{}{
+			"_embedded": map[string]interface{}{"content-repositories": pages[page]},
+			"page":      map[string]interface{}{"totalPages": 2, "number": page},
+		})
+	}))
+
+	d := &contentRepositoriesDataSource{}
+	d.Configure(ctx, datasource.ConfigureRequest{ProviderData: ci}, &datasource.ConfigureResponse{})
+	schemaResp := datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	config := tfsdk.Config{
+		Schema: schemaResp.Schema,
+		Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"hub_id":               tftypes.NewValue(tftypes.String, nil),
+			"content_repositories": tftypes.NewValue(objectType.AttributeTypes["content_repositories"], nil),
+			"ids":                  tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+		}),
+	}
+	resp := &datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	d.Read(ctx, datasource.ReadRequest{Config: config}, resp)
+	require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+
+	var result ContentRepositories
+	require.False(t, resp.State.Get(ctx, &result).HasError())
+	assert.Equal(t, testutils.HubID, result.HubID.ValueString())
+	require.Len(t, result.ContentRepositories, 2)
+
+	content := result.ContentRepositories[0]
+	assert.Equal(t, []types.String{types.StringValue("en-GB"), types.StringValue("nl-NL")}, content.ItemLocales)
+	assert.Empty(t, content.Features)
+	assert.Equal(t, []ContentType{{
+		ID:             types.StringValue("banner-id"),
+		ContentTypeURI: types.StringValue("https://schema.example.com/banner.json"),
+	}}, content.ContentTypes)
+
+	slots := result.ContentRepositories[1]
+	assert.Equal(t, []types.String{types.StringValue("slots")}, slots.Features)
+	assert.Empty(t, slots.ItemLocales)
+	assert.Equal(t, map[string]string{"content": "content-id", "slots": "slots-id"}, result.IDs)
+}
@@ -0,0 +1,67 @@
+package content_repositories
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/labd/terraform-provider-amplience/internal/api"
+)
+
+type ContentRepositories struct {
+	HubID               types.String        `tfsdk:"hub_id"`
+	ContentRepositories []ContentRepository `tfsdk:"content_repositories"`
+	IDs                 map[string]string   `tfsdk:"ids"`
+}
+
+type ContentRepository struct {
+	ID           types.String   `tfsdk:"id"`
+	Name         types.String   `tfsdk:"name"`
+	Label        types.String   `tfsdk:"label"`
+	Status       types.String   `tfsdk:"status"`
+	Features     []types.String `tfsdk:"features"`
+	ItemLocales  []types.String `tfsdk:"item_locales"`
+	ContentTypes []ContentType  `tfsdk:"content_types"`
+}
+
+type ContentType struct {
+	ID             types.String `tfsdk:"id"`
+	ContentTypeURI types.String `tfsdk:"content_type_uri"`
+}
+
+// NewContentRepositoriesFromNative returns the content repositories of a hub. Names are unique within a hub, so IDs
+// contains every repository.
+func NewContentRepositoriesFromNative(hubID string, repositories []api.ContentRepository) *ContentRepositories {
+	result := &ContentRepositories{
+		HubID:               types.StringValue(hubID),
+		ContentRepositories: []ContentRepository{},
+		IDs:                 map[string]string{},
+	}
+
+	for _, repository := range repositories {
+		contentTypes := []ContentType{}
+		for _, contentType := range repository.ContentTypes {
+			contentTypes = append(contentTypes, ContentType{
+				ID:             types.StringValue(contentType.HubContentTypeID),
+				ContentTypeURI: types.StringValue(contentType.ContentTypeURI),
+			})
+		}
+
+		result.ContentRepositories = append(result.ContentRepositories, ContentRepository{
+			ID:           types.StringValue(repository.ID),
+			Name:         types.StringValue(repository.Name),
+			Label:        types.StringValue(repository.Label),
+			Status:       types.StringValue(repository.Status),
+			Features:     stringValues(repository.Features),
+			ItemLocales:  stringValues(repository.ItemLocales),
+			ContentTypes: contentTypes,
+		})
+		result.IDs[repository.Name] = repository.ID
+	}
+	return result
+}
+
+func stringValues(values []string) []types.String {
+	result := []types.String{}
+	for _, value := range values {
+		result = append(result, types.StringValue(value))
+	}
+	return result
+}
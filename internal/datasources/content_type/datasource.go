@@ -0,0 +1,144 @@
+package content_type
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/labd/terraform-provider-amplience/internal/api"
+	"github.com/labd/terraform-provider-amplience/internal/config"
+	"github.com/labd/terraform-provider-amplience/internal/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &contentTypeDataSource{}
+	_ datasource.DataSourceWithConfigure = &contentTypeDataSource{}
+)
+
+// NewContentTypeDataSource is a helper function to simplify the provider implementation.
+func NewContentTypeDataSource() datasource.DataSource {
+	return &contentTypeDataSource{}
+}
+
+// contentTypeDataSource is the data source implementation.
+type contentTypeDataSource struct {
+	api   *api.Client
+	hubId string
+}
+
+// Metadata returns the data source type name.
+func (d *contentTypeDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_content_type"
+}
+
+// IconAttribute is the schema of the icons of a content type
+func IconAttribute() schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		Description: "Icons of the content type",
+		Computed:    true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"size": schema.Int64Attribute{
+					Computed: true,
+				},
+				"url": schema.StringAttribute{
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+// VisualizationAttribute is the schema of the visualizations of a content type
+func VisualizationAttribute() schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		Description: "Visualizations of the content type",
+		Computed:    true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"label": schema.StringAttribute{
+					Computed: true,
+				},
+				"templated_uri": schema.StringAttribute{
+					Computed: true,
+				},
+				"default": schema.BoolAttribute{
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+// Schema defines the schema for the data source.
+func (d *contentTypeDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "A content type registered with a hub, looked up by its `content_type_uri`. Archived " +
+			"content types are found as well, check `status` to tell them apart.\n" +
+			"For more info see [Amplience Content Type Docs](https://amplience.com/docs/integration/workingwithcontenttypes.html)",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the content type",
+				Computed:    true,
+			},
+			"hub_id": schema.StringAttribute{
+				Description: "ID of the Hub to look up the content type in. Defaults to the `hub_id` of the provider",
+				Optional:    true,
+				Computed:    true,
+				Validators:  []validator.String{utils.NoWhitespace()},
+			},
+			"content_type_uri": schema.StringAttribute{
+				Description: "URI of the schema of the content type",
+				Required:    true,
+				Validators:  []validator.String{utils.NoWhitespace()},
+			},
+			"status": schema.StringAttribute{
+				Description: "Status of the content type, `ACTIVE` or `ARCHIVED`",
+				Computed:    true,
+			},
+			"label": schema.StringAttribute{
+				Description: "Label of the content type",
+				Computed:    true,
+			},
+			"icon":          IconAttribute(),
+			"visualization": VisualizationAttribute(),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *contentTypeDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	data := req.ProviderData.(*config.ClientInfo)
+	d.api = data.API
+	d.hubId = data.HubID
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *contentTypeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config ContentType
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hubID, err := utils.RequireHubID(config.HubID, d.hubId)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("hub_id"), "Missing hub ID", err.Error())
+		return
+	}
+	instance, err := d.api.ContentTypeFindByURI(ctx, hubID, config.ContentTypeURI.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read content type", utils.ErrorDetail(err))
+		return
+	}
+
+	diags = resp.State.Set(ctx, NewContentTypeFromNative(hubID, &instance))
+	resp.Diagnostics.Append(diags...)
+}
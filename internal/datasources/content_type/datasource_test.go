@@ -0,0 +1,110 @@
+package content_type
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/labd/terraform-provider-amplience/internal/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContentTypeDataSourceRead(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name  string
+		uri   string
+		id    string
+		error string
+	}{
+		{name: "finds the content type", uri: "https://schema.example.com/banner.json", id: "banner-id"},
+		{name: "finds archived content types", uri: "https://schema.example.com/old.json", id: "old-id"},
+		{name: "fails for an unknown uri", uri: "https://schema.example.com/missing.json", error: "no content type found with content_type_uri"},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+
+			ci := testutils.NewClientInfo(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				require.Equal(t, "/hubs/test-hub/content-types", r.URL.Path)
+				assert.Equal(t, "ACTIVE,ARCHIVED", r.URL.Query().Get("status"))
+				testutils.WriteJSON(w, http.StatusOK, map[string]interface{}{
+					"_embedded": map[string]interface{}{
+						"content-types": []map[string]interface{}{
+							{
+								"id":             "banner-id",
+								"contentTypeUri": "https://schema.example.com/banner.json",
+								"status":         "ACTIVE",
+								"settings": map[string]interface{}{
+									"label": "Banner",
+									"icons": []map[string]interface{}{{"size": 256, "url": "https://example.com/banner.png"}},
+									"visualizations": []map[string]interface{}{
+										{"label": "Preview", "templatedUri": "https://preview.example.com/{{content.sys.id}}", "default": true},
+									},
+								},
+							},
+							{
+								"id":             "old-id",
+								"contentTypeUri": "https://schema.example.com/old.json",
+								"status":         "ARCHIVED",
+								"settings":       map[string]interface{}{"label": "Old"},
+							},
+						},
+					},
+					"page": map[string]interface{}{"totalPages": 1},
+				})
+			}))
+
+			d := &contentTypeDataSource{}
+			d.Configure(ctx, datasource.ConfigureRequest{ProviderData: ci}, &datasource.ConfigureResponse{})
+			schemaResp := datasource.SchemaResponse{}
+			d.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+
+			objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+			config := tfsdk.Config{
+				Schema: schemaResp.Schema,
+				Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+					"id":               tftypes.NewValue(tftypes.String, nil),
+					"hub_id":           tftypes.NewValue(tftypes.String, nil),
+					"content_type_uri": tftypes.NewValue(tftypes.String, tc.uri),
+					"status":           tftypes.NewValue(tftypes.String, nil),
+					"label":            tftypes.NewValue(tftypes.String, nil),
+					"icon":             tftypes.NewValue(objectType.AttributeTypes["icon"], nil),
+					"visualization":    tftypes.NewValue(objectType.AttributeTypes["visualization"], nil),
+				}),
+			}
+			resp := &datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+			d.Read(ctx, datasource.ReadRequest{Config: config}, resp)
+			if tc.error != "" {
+				require.True(t, resp.Diagnostics.HasError())
+				assert.Equal(t, "Failed to read content type", resp.Diagnostics.Errors()[0].Summary())
+				assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), tc.error)
+				return
+			}
+			require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+
+			var result ContentType
+			require.False(t, resp.State.Get(ctx, &result).HasError())
+			assert.Equal(t, tc.id, result.ID.ValueString())
+			assert.Equal(t, testutils.HubID, result.HubID.ValueString())
+			if tc.id == "banner-id" {
+				assert.Equal(t, "ACTIVE", result.Status.ValueString())
+				assert.Equal(t, []Icon{{Size: types.Int64Value(256), URL: types.StringValue("https://example.com/banner.png")}}, result.Icons)
+				require.Len(t, result.Visualizations, 1)
+				assert.True(t, result.Visualizations[0].Default.ValueBool())
+			} else {
+				assert.Equal(t, "ARCHIVED", result.Status.ValueString())
+				assert.Empty(t, result.Icons)
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package content_type
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/labd/amplience-go-sdk/content"
+)
+
+type ContentType struct {
+	ID             types.String    `tfsdk:"id"`
+	HubID          types.String    `tfsdk:"hub_id"`
+	ContentTypeURI types.String    `tfsdk:"content_type_uri"`
+	Status         types.String    `tfsdk:"status"`
+	Label          types.String    `tfsdk:"label"`
+	Icons          []Icon          `tfsdk:"icon"`
+	Visualizations []Visualization `tfsdk:"visualization"`
+}
+
+type Icon struct {
+	Size types.Int64  `tfsdk:"size"`
+	URL  types.String `tfsdk:"url"`
+}
+
+type Visualization struct {
+	Label        types.String `tfsdk:"label"`
+	TemplatedURI types.String `tfsdk:"templated_uri"`
+	Default      types.Bool   `tfsdk:"default"`
+}
+
+func NewContentTypeFromNative(hubID string, c *content.ContentType) *ContentType {
+	return &ContentType{
+		ID:             types.StringValue(c.ID),
+		HubID:          types.StringValue(hubID),
+		ContentTypeURI: types.StringValue(c.ContentTypeURI),
+		Status:         types.StringValue(c.Status),
+		Label:          types.StringValue(c.Settings.Label),
+		Icons:          NewIconsFromNative(c.Settings.Icons),
+		Visualizations: NewVisualizationsFromNative(c.Settings.Visualizations),
+	}
+}
+
+func NewIconsFromNative(icons []content.ContentTypeIcon) []Icon {
+	result := []Icon{}
+	for _, icon := range icons {
+		result = append(result, Icon{
+			Size: types.Int64Value(int64(icon.Size)),
+			URL:  types.StringValue(icon.URL),
+		})
+	}
+	return result
+}
+
+func NewVisualizationsFromNative(visualizations []content.ContentTypeVisualization) []Visualization {
+	result := []Visualization{}
+	for _, visualization := range visualizations {
+		result = append(result, Visualization{
+			Label:        types.StringValue(visualization.Label),
+			TemplatedURI: types.StringValue(visualization.TemplatedURI),
+			Default:      types.BoolValue(visualization.Default),
+		})
+	}
+	return result
+}
@@ -0,0 +1,114 @@
+package content_type_schema
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/labd/terraform-provider-amplience/internal/api"
+	"github.com/labd/terraform-provider-amplience/internal/config"
+	"github.com/labd/terraform-provider-amplience/internal/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &contentTypeSchemaDataSource{}
+	_ datasource.DataSourceWithConfigure = &contentTypeSchemaDataSource{}
+)
+
+// NewContentTypeSchemaDataSource is a helper function to simplify the provider implementation.
+func NewContentTypeSchemaDataSource() datasource.DataSource {
+	return &contentTypeSchemaDataSource{}
+}
+
+// contentTypeSchemaDataSource is the data source implementation.
+type contentTypeSchemaDataSource struct {
+	api   *api.Client
+	hubId string
+}
+
+// Metadata returns the data source type name.
+func (d *contentTypeSchemaDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_content_type_schema"
+}
+
+// Schema defines the schema for the data source.
+func (d *contentTypeSchemaDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "A content type schema of a hub, looked up by its `schema_id`. Archived schemas are " +
+			"found as well, check `status` to tell them apart.\n" +
+			"For more info see [Amplience Content Type Schema Docs](https://amplience.com/docs/integration/contenttypes.html)",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the content type schema",
+				Computed:    true,
+			},
+			"hub_id": schema.StringAttribute{
+				Description: "ID of the Hub to look up the content type schema in. Defaults to the `hub_id` of the " +
+					"provider",
+				Optional:   true,
+				Computed:   true,
+				Validators: []validator.String{utils.NoWhitespace()},
+			},
+			"schema_id": schema.StringAttribute{
+				Description: "Unique schema ID",
+				Required:    true,
+				Validators:  []validator.String{utils.NoWhitespace()},
+			},
+			"status": schema.StringAttribute{
+				Description: "Status of the content type schema, `ACTIVE` or `ARCHIVED`",
+				Computed:    true,
+			},
+			"version": schema.Int64Attribute{
+				Description: "Version of the content type schema",
+				Computed:    true,
+			},
+			"body": schema.StringAttribute{
+				Description: "JSON definition of the schema",
+				Computed:    true,
+				CustomType:  jsontypes.NormalizedType{},
+			},
+			"validation_level": schema.StringAttribute{
+				Description: "Validation level of the schema, such as `CONTENT_TYPE`",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *contentTypeSchemaDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	data := req.ProviderData.(*config.ClientInfo)
+	d.api = data.API
+	d.hubId = data.HubID
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *contentTypeSchemaDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config ContentTypeSchema
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hubID, err := utils.RequireHubID(config.HubID, d.hubId)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("hub_id"), "Missing hub ID", err.Error())
+		return
+	}
+	instance, err := d.api.ContentTypeSchemaFindBySchemaID(ctx, hubID, config.SchemaID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read content type schema", utils.ErrorDetail(err))
+		return
+	}
+
+	diags = resp.State.Set(ctx, NewContentTypeSchemaFromNative(hubID, &instance))
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,89 @@
+package content_type_schema
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/labd/terraform-provider-amplience/internal/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContentTypeSchemaDataSourceRead(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		schemaID string
+		error    string
+	}{
+		{name: "finds the schema", schemaID: "https://schema.example.com/banner.json"},
+		{name: "fails for an unknown schema", schemaID: "https://schema.example.com/missing.json", error: "no content type schema found with schema_id"},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+
+			ci := testutils.NewClientInfo(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				require.Equal(t, "/hubs/test-hub/content-type-schemas", r.URL.Path)
+				assert.Empty(t, r.URL.Query().Get("status"))
+				testutils.WriteJSON(w, http.StatusOK, map[string]interface{}{
+					"_embedded": map[string]interface{}{
+						"content-type-schemas": []map[string]interface{}{
+							{
+								"id":              "schema-id",
+								"schemaId":        "https://schema.example.com/banner.json",
+								"status":          "ACTIVE",
+								"version":         3,
+								"body":            `{"$id": "https://schema.example.com/banner.json"}`,
+								"validationLevel": "CONTENT_TYPE",
+							},
+						},
+					},
+					"page": map[string]interface{}{"totalPages": 1},
+				})
+			}))
+
+			d := &contentTypeSchemaDataSource{}
+			d.Configure(ctx, datasource.ConfigureRequest{ProviderData: ci}, &datasource.ConfigureResponse{})
+			schemaResp := datasource.SchemaResponse{}
+			d.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+
+			config := tfsdk.Config{
+				Schema: schemaResp.Schema,
+				Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), map[string]tftypes.Value{
+					"id":               tftypes.NewValue(tftypes.String, nil),
+					"hub_id":           tftypes.NewValue(tftypes.String, nil),
+					"schema_id":        tftypes.NewValue(tftypes.String, tc.schemaID),
+					"status":           tftypes.NewValue(tftypes.String, nil),
+					"version":          tftypes.NewValue(tftypes.Number, nil),
+					"body":             tftypes.NewValue(tftypes.String, nil),
+					"validation_level": tftypes.NewValue(tftypes.String, nil),
+				}),
+			}
+			resp := &datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+			d.Read(ctx, datasource.ReadRequest{Config: config}, resp)
+			if tc.error != "" {
+				require.True(t, resp.Diagnostics.HasError())
+				assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), tc.error)
+				return
+			}
+			require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+
+			var result ContentTypeSchema
+			require.False(t, resp.State.Get(ctx, &result).HasError())
+			assert.Equal(t, "schema-id", result.ID.ValueString())
+			assert.Equal(t, "ACTIVE", result.Status.ValueString())
+			assert.Equal(t, int64(3), result.Version.ValueInt64())
+			assert.Equal(t, "CONTENT_TYPE", result.ValidationLevel.ValueString())
+			assert.JSONEq(t, `{"$id": "https://schema.example.com/banner.json"}`, result.Body.ValueString())
+		})
+	}
+}
@@ -0,0 +1,29 @@
+package content_type_schema
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/labd/amplience-go-sdk/content"
+)
+
+type ContentTypeSchema struct {
+	ID              types.String         `tfsdk:"id"`
+	HubID           types.String         `tfsdk:"hub_id"`
+	SchemaID        types.String         `tfsdk:"schema_id"`
+	Status          types.String         `tfsdk:"status"`
+	Version         types.Int64          `tfsdk:"version"`
+	Body            jsontypes.Normalized `tfsdk:"body"`
+	ValidationLevel types.String         `tfsdk:"validation_level"`
+}
+
+func NewContentTypeSchemaFromNative(hubID string, s *content.ContentTypeSchema) *ContentTypeSchema {
+	return &ContentTypeSchema{
+		ID:              types.StringValue(s.ID),
+		HubID:           types.StringValue(hubID),
+		SchemaID:        types.StringValue(s.SchemaID),
+		Status:          types.StringValue(s.Status),
+		Version:         types.Int64Value(int64(s.Version)),
+		Body:            jsontypes.NewNormalizedValue(s.Body),
+		ValidationLevel: types.StringValue(s.ValidationLevel),
+	}
+}
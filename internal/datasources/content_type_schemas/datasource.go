@@ -0,0 +1,136 @@
+package content_type_schemas
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/labd/amplience-go-sdk/content"
+	"github.com/labd/terraform-provider-amplience/internal/api"
+	"github.com/labd/terraform-provider-amplience/internal/config"
+	"github.com/labd/terraform-provider-amplience/internal/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &contentTypeSchemasDataSource{}
+	_ datasource.DataSourceWithConfigure = &contentTypeSchemasDataSource{}
+)
+
+// NewContentTypeSchemasDataSource is a helper function to simplify the provider implementation.
+func NewContentTypeSchemasDataSource() datasource.DataSource {
+	return &contentTypeSchemasDataSource{}
+}
+
+// contentTypeSchemasDataSource is the data source implementation.
+type contentTypeSchemasDataSource struct {
+	api   *api.Client
+	hubId string
+}
+
+// Metadata returns the data source type name.
+func (d *contentTypeSchemasDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_content_type_schemas"
+}
+
+// Schema defines the schema for the data source.
+func (d *contentTypeSchemasDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "All content type schemas of a hub, optionally filtered by status. Use `ids` to refer " +
+			"to a content type schema by its `schema_id`.",
+		Attributes: map[string]schema.Attribute{
+			"hub_id": schema.StringAttribute{
+				Description: "ID of the Hub to read the content type schemas of. Defaults to the `hub_id` of the " +
+					"provider",
+				Optional:   true,
+				Computed:   true,
+				Validators: []validator.String{utils.NoWhitespace()},
+			},
+			"status": schema.StringAttribute{
+				Description: "Only read content type schemas with this status, `ACTIVE` or `ARCHIVED`. By default " +
+					"schemas with any status are read",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(string(content.StatusActive), string(content.StatusArchived)),
+				},
+			},
+			"content_type_schemas": schema.ListNestedAttribute{
+				Description: "The content type schemas of the hub",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "ID of the content type schema",
+							Computed:    true,
+						},
+						"schema_id": schema.StringAttribute{
+							Description: "Unique schema ID",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "Status of the content type schema, `ACTIVE` or `ARCHIVED`",
+							Computed:    true,
+						},
+						"version": schema.Int64Attribute{
+							Description: "Version of the content type schema",
+							Computed:    true,
+						},
+						"body": schema.StringAttribute{
+							Description: "JSON definition of the schema",
+							Computed:    true,
+							CustomType:  jsontypes.NormalizedType{},
+						},
+						"validation_level": schema.StringAttribute{
+							Description: "Validation level of the schema, such as `CONTENT_TYPE`",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"ids": schema.MapAttribute{
+				Description: "The IDs of the content type schemas by `schema_id`",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *contentTypeSchemasDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	data := req.ProviderData.(*config.ClientInfo)
+	d.api = data.API
+	d.hubId = data.HubID
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *contentTypeSchemasDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config ContentTypeSchemas
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hubID, err := utils.RequireHubID(config.HubID, d.hubId)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("hub_id"), "Missing hub ID", err.Error())
+		return
+	}
+	schemas, err := d.api.ContentTypeSchemaGetAll(ctx, hubID, content.ContentStatus(config.Status.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read content type schemas", utils.ErrorDetail(err))
+		return
+	}
+
+	diags = resp.State.Set(ctx, NewContentTypeSchemasFromNative(hubID, config.Status, schemas))
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,65 @@
+package content_type_schemas
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/labd/terraform-provider-amplience/internal/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContentTypeSchemasDataSourceRead(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	pages := [][]map[string]interface{}{
+		{{"id": "banner-id", "schemaId": "https://schema.example.com/banner.json", "status": "ACTIVE", "version": 1, "body": "{}"}},
+		{{"id": "card-id", "schemaId": "https://schema.example.com/card.json", "status": "ACTIVE", "version": 2, "body": "{}"}},
+	}
+	ci := testutils.NewClientInfo(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/hubs/test-hub/content-type-schemas", r.URL.Path)
+		assert.Equal(t, "ACTIVE", r.URL.Query().Get("status"))
+		page := 0
+		if r.URL.Query().Get("page") == "1" {
+			page = 1
+		}
+		testutils.WriteJSON(w, http.StatusOK, map[string]interface{}{
+			"_embedded": map[string]interface{}{"content-type-schemas": pages[page]},
+			"page":      map[string]interface{}{"totalPages": 2, "number": page},
+		})
+	}))
+
+	d := &contentTypeSchemasDataSource{}
+	d.Configure(ctx, datasource.ConfigureRequest{ProviderData: ci}, &datasource.ConfigureResponse{})
+	schemaResp := datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	config := tfsdk.Config{
+		Schema: schemaResp.Schema,
+		Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"hub_id":               tftypes.NewValue(tftypes.String, nil),
+			"status":               tftypes.NewValue(tftypes.String, "ACTIVE"),
+			"content_type_schemas": tftypes.NewValue(objectType.AttributeTypes["content_type_schemas"], nil),
+			"ids":                  tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+		}),
+	}
+	resp := &datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	d.Read(ctx, datasource.ReadRequest{Config: config}, resp)
+	require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+
+	var result ContentTypeSchemas
+	require.False(t, resp.State.Get(ctx, &result).HasError())
+	assert.Equal(t, "ACTIVE", result.Status.ValueString())
+	require.Len(t, result.ContentTypeSchemas, 2)
+	assert.Equal(t, int64(2), result.ContentTypeSchemas[1].Version.ValueInt64())
+	assert.Equal(t, map[string]string{
+		"https://schema.example.com/banner.json": "banner-id",
+		"https://schema.example.com/card.json":   "card-id",
+	}, result.IDs)
+}
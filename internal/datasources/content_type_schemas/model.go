@@ -0,0 +1,47 @@
+package content_type_schemas
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/labd/amplience-go-sdk/content"
+)
+
+type ContentTypeSchemas struct {
+	HubID              types.String        `tfsdk:"hub_id"`
+	Status             types.String        `tfsdk:"status"`
+	ContentTypeSchemas []ContentTypeSchema `tfsdk:"content_type_schemas"`
+	IDs                map[string]string   `tfsdk:"ids"`
+}
+
+type ContentTypeSchema struct {
+	ID              types.String         `tfsdk:"id"`
+	SchemaID        types.String         `tfsdk:"schema_id"`
+	Status          types.String         `tfsdk:"status"`
+	Version         types.Int64          `tfsdk:"version"`
+	Body            jsontypes.Normalized `tfsdk:"body"`
+	ValidationLevel types.String         `tfsdk:"validation_level"`
+}
+
+// NewContentTypeSchemasFromNative returns the content type schemas of a hub. The schema ID is unique within a hub, so
+// IDs contains every schema.
+func NewContentTypeSchemasFromNative(hubID string, status types.String, schemas []content.ContentTypeSchema) *ContentTypeSchemas {
+	result := &ContentTypeSchemas{
+		HubID:              types.StringValue(hubID),
+		Status:             status,
+		ContentTypeSchemas: []ContentTypeSchema{},
+		IDs:                map[string]string{},
+	}
+
+	for _, s := range schemas {
+		result.ContentTypeSchemas = append(result.ContentTypeSchemas, ContentTypeSchema{
+			ID:              types.StringValue(s.ID),
+			SchemaID:        types.StringValue(s.SchemaID),
+			Status:          types.StringValue(s.Status),
+			Version:         types.Int64Value(int64(s.Version)),
+			Body:            jsontypes.NewNormalizedValue(s.Body),
+			ValidationLevel: types.StringValue(s.ValidationLevel),
+		})
+		result.IDs[s.SchemaID] = s.ID
+	}
+	return result
+}
@@ -0,0 +1,128 @@
+package content_types
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/labd/amplience-go-sdk/content"
+	"github.com/labd/terraform-provider-amplience/internal/api"
+	"github.com/labd/terraform-provider-amplience/internal/config"
+	"github.com/labd/terraform-provider-amplience/internal/datasources/content_type"
+	"github.com/labd/terraform-provider-amplience/internal/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &contentTypesDataSource{}
+	_ datasource.DataSourceWithConfigure = &contentTypesDataSource{}
+)
+
+// NewContentTypesDataSource is a helper function to simplify the provider implementation.
+func NewContentTypesDataSource() datasource.DataSource {
+	return &contentTypesDataSource{}
+}
+
+// contentTypesDataSource is the data source implementation.
+type contentTypesDataSource struct {
+	api   *api.Client
+	hubId string
+}
+
+// Metadata returns the data source type name.
+func (d *contentTypesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_content_types"
+}
+
+// Schema defines the schema for the data source.
+func (d *contentTypesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "All content types registered with a hub, optionally filtered by status. Use `ids` to " +
+			"refer to a content type by its `content_type_uri`.",
+		Attributes: map[string]schema.Attribute{
+			"hub_id": schema.StringAttribute{
+				Description: "ID of the Hub to read the content types of. Defaults to the `hub_id` of the provider",
+				Optional:    true,
+				Computed:    true,
+				Validators:  []validator.String{utils.NoWhitespace()},
+			},
+			"status": schema.StringAttribute{
+				Description: "Only read content types with this status, `ACTIVE` or `ARCHIVED`. By default content " +
+					"types with any status are read",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(string(content.StatusActive), string(content.StatusArchived)),
+				},
+			},
+			"content_types": schema.ListNestedAttribute{
+				Description: "The content types of the hub",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "ID of the content type",
+							Computed:    true,
+						},
+						"content_type_uri": schema.StringAttribute{
+							Description: "URI of the schema of the content type",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "Status of the content type, `ACTIVE` or `ARCHIVED`",
+							Computed:    true,
+						},
+						"label": schema.StringAttribute{
+							Description: "Label of the content type",
+							Computed:    true,
+						},
+						"icon":          content_type.IconAttribute(),
+						"visualization": content_type.VisualizationAttribute(),
+					},
+				},
+			},
+			"ids": schema.MapAttribute{
+				Description: "The IDs of the content types by `content_type_uri`",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *contentTypesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	data := req.ProviderData.(*config.ClientInfo)
+	d.api = data.API
+	d.hubId = data.HubID
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *contentTypesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config ContentTypes
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hubID, err := utils.RequireHubID(config.HubID, d.hubId)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("hub_id"), "Missing hub ID", err.Error())
+		return
+	}
+	contentTypes, err := d.api.ContentTypeGetAll(ctx, hubID, content.ContentStatus(config.Status.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read content types", utils.ErrorDetail(err))
+		return
+	}
+
+	diags = resp.State.Set(ctx, NewContentTypesFromNative(hubID, config.Status, contentTypes))
+	resp.Diagnostics.Append(diags...)
+}
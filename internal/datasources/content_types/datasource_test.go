@@ -0,0 +1,78 @@
+package content_types
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/labd/terraform-provider-amplience/internal/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContentTypesDataSourceRead(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name        string
+		status      interface{}
+		queryStatus string
+	}{
+		{name: "any status", status: nil, queryStatus: "ACTIVE,ARCHIVED"},
+		{name: "archived only", status: "ARCHIVED", queryStatus: "ARCHIVED"},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+
+			ci := testutils.NewClientInfo(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				require.Equal(t, "/hubs/test-hub/content-types", r.URL.Path)
+				assert.Equal(t, tc.queryStatus, r.URL.Query().Get("status"))
+				testutils.WriteJSON(w, http.StatusOK, map[string]interface{}{
+					"_embedded": map[string]interface{}{
+						"content-types": []map[string]interface{}{
+							{
+								"id":             "old-id",
+								"contentTypeUri": "https://schema.example.com/old.json",
+								"status":         "ARCHIVED",
+								"settings":       map[string]interface{}{"label": "Old"},
+							},
+						},
+					},
+					"page": map[string]interface{}{"totalPages": 1},
+				})
+			}))
+
+			d := &contentTypesDataSource{}
+			d.Configure(ctx, datasource.ConfigureRequest{ProviderData: ci}, &datasource.ConfigureResponse{})
+			schemaResp := datasource.SchemaResponse{}
+			d.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+
+			objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+			config := tfsdk.Config{
+				Schema: schemaResp.Schema,
+				Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+					"hub_id":        tftypes.NewValue(tftypes.String, nil),
+					"status":        tftypes.NewValue(tftypes.String, tc.status),
+					"content_types": tftypes.NewValue(objectType.AttributeTypes["content_types"], nil),
+					"ids":           tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				}),
+			}
+			resp := &datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+			d.Read(ctx, datasource.ReadRequest{Config: config}, resp)
+			require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+
+			var result ContentTypes
+			require.False(t, resp.State.Get(ctx, &result).HasError())
+			require.Len(t, result.ContentTypes, 1)
+			assert.Equal(t, "Old", result.ContentTypes[0].Label.ValueString())
+			assert.Equal(t, map[string]string{"https://schema.example.com/old.json": "old-id"}, result.IDs)
+		})
+	}
+}
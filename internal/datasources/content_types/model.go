@@ -0,0 +1,47 @@
+package content_types
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/labd/amplience-go-sdk/content"
+	"github.com/labd/terraform-provider-amplience/internal/datasources/content_type"
+)
+
+type ContentTypes struct {
+	HubID        types.String      `tfsdk:"hub_id"`
+	Status       types.String      `tfsdk:"status"`
+	ContentTypes []ContentType     `tfsdk:"content_types"`
+	IDs          map[string]string `tfsdk:"ids"`
+}
+
+type ContentType struct {
+	ID             types.String                 `tfsdk:"id"`
+	ContentTypeURI types.String                 `tfsdk:"content_type_uri"`
+	Status         types.String                 `tfsdk:"status"`
+	Label          types.String                 `tfsdk:"label"`
+	Icons          []content_type.Icon          `tfsdk:"icon"`
+	Visualizations []content_type.Visualization `tfsdk:"visualization"`
+}
+
+// NewContentTypesFromNative returns the content types of a hub. The content type URI is unique within a hub, so IDs
+// contains every content type.
+func NewContentTypesFromNative(hubID string, status types.String, contentTypes []content.ContentType) *ContentTypes {
+	result := &ContentTypes{
+		HubID:        types.StringValue(hubID),
+		Status:       status,
+		ContentTypes: []ContentType{},
+		IDs:          map[string]string{},
+	}
+
+	for _, c := range contentTypes {
+		result.ContentTypes = append(result.ContentTypes, ContentType{
+			ID:             types.StringValue(c.ID),
+			ContentTypeURI: types.StringValue(c.ContentTypeURI),
+			Status:         types.StringValue(c.Status),
+			Label:          types.StringValue(c.Settings.Label),
+			Icons:          content_type.NewIconsFromNative(c.Settings.Icons),
+			Visualizations: content_type.NewVisualizationsFromNative(c.Settings.Visualizations),
+		})
+		result.IDs[c.ContentTypeURI] = c.ID
+	}
+	return result
+}
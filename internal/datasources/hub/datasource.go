@@ -0,0 +1,219 @@
+package hub
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/labd/amplience-go-sdk/content"
+	"github.com/labd/terraform-provider-amplience/internal/config"
+	hubresource "github.com/labd/terraform-provider-amplience/internal/resources/hub"
+	"github.com/labd/terraform-provider-amplience/internal/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &hubDataSource{}
+	_ datasource.DataSourceWithConfigure = &hubDataSource{}
+)
+
+// NewHubDataSource is a helper function to simplify the provider implementation.
+func NewHubDataSource() datasource.DataSource {
+	return &hubDataSource{}
+}
+
+// hubDataSource is the data source implementation.
+type hubDataSource struct {
+	client *content.Client
+	hubId  string
+}
+
+// Metadata returns the data source type name.
+func (d *hubDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_hub"
+}
+
+// Schema defines the schema for the data source.
+func (d *hubDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Permissions are set at the hub level. All users of a hub can at least view all of the " +
+			"content within the repositories inside that hub. Content cannot be shared across hubs. However, content " +
+			"can be shared and linked to across repositories within the same hub. So you can create a content item " +
+			"in one repository and include content stored in another. Events and editions are scheduled within a " +
+			"single hub. So if you want an overall view of the planning calendar across many brands, then you may wish " +
+			"to consider a single hub. However, in some cases you may want to keep the calendars separate. Many " +
+			"settings, such as the publishing endpoint (the subdomain to which your content is published) are set at " +
+			"a hub level. Multiple hubs may publish content to the same endpoint.\n" +
+			"For more info see [Amplience Hubs & Repositories Docs](https://amplience.com/docs/intro/hubsandrepositories.html)",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the Hub to read. Defaults to the `hub_id` of the provider",
+				Optional:    true,
+				Computed:    true,
+				Validators:  []validator.String{utils.NoWhitespace()},
+			},
+			"hub_id": schema.StringAttribute{
+				Description: "ID of the Hub",
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Hub name",
+				Computed:    true,
+			},
+			"label": schema.StringAttribute{
+				Description: "Hub label",
+				Computed:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Hub description",
+				Computed:    true,
+			},
+			"settings": schema.SingleNestedAttribute{
+				Description: "Hub settings",
+				Computed:    true,
+				Attributes: map[string]schema.Attribute{
+					"publishing": schema.SingleNestedAttribute{
+						Computed: true,
+						Attributes: map[string]schema.Attribute{
+							"platforms": schema.SingleNestedAttribute{
+								Computed: true,
+								Attributes: map[string]schema.Attribute{
+									"amplience_dam": schema.SingleNestedAttribute{
+										Computed: true,
+										Attributes: map[string]schema.Attribute{
+											"api_key": schema.StringAttribute{
+												Description: "DAM publishing client key",
+												Computed:    true,
+											},
+											"api_secret": schema.StringAttribute{
+												Description: "DAM publishing client secret. This is never read, so " +
+													"it is always null",
+												Computed:  true,
+												Sensitive: true,
+											},
+											"endpoint": schema.StringAttribute{
+												Description: "Publishing endpoint, also known as Company Tag",
+												Computed:    true,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+					"devices": schema.ListNestedAttribute{
+						Computed: true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"name": schema.StringAttribute{
+									Computed: true,
+								},
+								"width": schema.Int64Attribute{
+									Computed: true,
+								},
+								"height": schema.Int64Attribute{
+									Computed: true,
+								},
+								"orientate": schema.BoolAttribute{
+									Computed: true,
+								},
+							},
+						},
+					},
+					"localization": schema.SingleNestedAttribute{
+						Computed: true,
+						Attributes: map[string]schema.Attribute{
+							"locales": schema.ListAttribute{
+								Computed:    true,
+								ElementType: types.StringType,
+							},
+						},
+					},
+					"applications": schema.ListNestedAttribute{
+						Computed: true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"name": schema.StringAttribute{
+									Computed: true,
+								},
+								"template_uri": schema.StringAttribute{
+									Computed: true,
+								},
+							},
+						},
+					},
+					"preview_virtual_staging_environment": schema.SingleNestedAttribute{
+						Computed: true,
+						Attributes: map[string]schema.Attribute{
+							"hostname": schema.StringAttribute{
+								Description: "Virtual Staging Environment hostname",
+								Computed:    true,
+							},
+						},
+					},
+					"virtual_staging_environment": schema.SingleNestedAttribute{
+						Computed: true,
+						Attributes: map[string]schema.Attribute{
+							"hostname": schema.StringAttribute{
+								Description: "Virtual Staging Environment hostname",
+								Computed:    true,
+							},
+						},
+					},
+					"asset_management": schema.SingleNestedAttribute{
+						Computed: true,
+						Attributes: map[string]schema.Attribute{
+							"enabled": schema.BoolAttribute{
+								Computed: true,
+							},
+							"client_config": schema.StringAttribute{
+								Computed: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *hubDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	data := req.ProviderData.(*config.ClientInfo)
+	d.client = data.Client
+	d.hubId = data.HubID
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *hubDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config hubresource.Hub
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hubID, err := utils.RequireHubID(config.ID, d.hubId)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("id"), "Missing hub ID", err.Error())
+		return
+	}
+
+	instance, err := d.client.HubGet(hubID)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read hub", utils.ErrorDetail(err))
+		return
+	}
+
+	result := hubresource.NewHubFromNative(&instance)
+	result.OmitSecrets()
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,118 @@
+package hub
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	hubresource "github.com/labd/terraform-provider-amplience/internal/resources/hub"
+	"github.com/labd/terraform-provider-amplience/internal/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestConfig(ctx context.Context, schemaResp datasource.SchemaResponse, id interface{}) tfsdk.Config {
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	return tfsdk.Config{
+		Schema: schemaResp.Schema,
+		Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"id":          tftypes.NewValue(tftypes.String, id),
+			"hub_id":      tftypes.NewValue(tftypes.String, nil),
+			"name":        tftypes.NewValue(tftypes.String, nil),
+			"label":       tftypes.NewValue(tftypes.String, nil),
+			"description": tftypes.NewValue(tftypes.String, nil),
+			"settings":    tftypes.NewValue(objectType.AttributeTypes["settings"], nil),
+		}),
+	}
+}
+
+func TestHubDataSourceRead(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name  string
+		id    interface{}
+		hubID string
+	}{
+		{name: "provider hub", id: nil, hubID: testutils.HubID},
+		{name: "explicit hub", id: "other-hub", hubID: "other-hub"},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+
+			ci := testutils.NewClientInfo(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				require.Equal(t, "/hubs/"+tc.hubID, r.URL.Path)
+				testutils.WriteJSON(w, http.StatusOK, map[string]interface{}{
+					"id":          tc.hubID,
+					"name":        "my-hub",
+					"label":       "My Hub",
+					"description": "The hub",
+					"settings": map[string]interface{}{
+						"publishing": map[string]interface{}{
+							"platforms": map[string]interface{}{
+								"amplience_dam": map[string]interface{}{
+									"API_KEY":    "key",
+									"API_SECRET": "secret",
+									"endpoint":   "my-endpoint",
+								},
+							},
+						},
+						"localization": map[string]interface{}{"locales": []string{"en-GB", "nl-NL"}},
+						"virtualStagingEnvironment": map[string]interface{}{
+							"hostname": "staging.example.com",
+						},
+					},
+				})
+			}))
+
+			d := &hubDataSource{}
+			d.Configure(ctx, datasource.ConfigureRequest{ProviderData: ci}, &datasource.ConfigureResponse{})
+			schemaResp := datasource.SchemaResponse{}
+			d.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+
+			config := newTestConfig(ctx, schemaResp, tc.id)
+			resp := &datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+			d.Read(ctx, datasource.ReadRequest{Config: config}, resp)
+			require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+
+			var result hubresource.Hub
+			require.False(t, resp.State.Get(ctx, &result).HasError())
+			assert.Equal(t, tc.hubID, result.ID.ValueString())
+			assert.Equal(t, "My Hub", result.Label.ValueString())
+			assert.Equal(t, "The hub", result.Description.ValueString())
+			require.NotNil(t, result.Settings)
+
+			dam := result.Settings.Publishing.Platforms.AmplienceDAM
+			assert.Equal(t, "key", dam.APIKey.ValueString())
+			assert.Equal(t, "my-endpoint", dam.Endpoint.ValueString())
+			assert.True(t, dam.APISecret.IsNull())
+			assert.Equal(t, []types.String{types.StringValue("en-GB"), types.StringValue("nl-NL")}, result.Settings.Localization.Locales)
+			assert.Equal(t, "staging.example.com", result.Settings.VirtualStagingEnvironment.Hostname.ValueString())
+		})
+	}
+}
+
+func TestHubDataSourceReadNotFound(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ci := testutils.NewClientInfo(t, http.HandlerFunc(testutils.NotFound))
+
+	d := &hubDataSource{}
+	d.Configure(ctx, datasource.ConfigureRequest{ProviderData: ci}, &datasource.ConfigureResponse{})
+	schemaResp := datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+
+	config := newTestConfig(ctx, schemaResp, nil)
+	resp := &datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	d.Read(ctx, datasource.ReadRequest{Config: config}, resp)
+	require.True(t, resp.Diagnostics.HasError())
+	assert.Equal(t, "Failed to read hub", resp.Diagnostics.Errors()[0].Summary())
+}
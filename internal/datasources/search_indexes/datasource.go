@@ -0,0 +1,147 @@
+package search_indexes
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/labd/terraform-provider-amplience/internal/api"
+	"github.com/labd/terraform-provider-amplience/internal/config"
+	"github.com/labd/terraform-provider-amplience/internal/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &searchIndexesDataSource{}
+	_ datasource.DataSourceWithConfigure = &searchIndexesDataSource{}
+)
+
+// NewSearchIndexesDataSource is a helper function to simplify the provider implementation.
+func NewSearchIndexesDataSource() datasource.DataSource {
+	return &searchIndexesDataSource{}
+}
+
+// searchIndexesDataSource is the data source implementation.
+type searchIndexesDataSource struct {
+	api   *api.Client
+	hubId string
+}
+
+// Metadata returns the data source type name.
+func (d *searchIndexesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_search_indexes"
+}
+
+// Schema defines the schema for the data source.
+func (d *searchIndexesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "All Algolia search indexes of a hub, including replicas, with their assigned content " +
+			"types and the webhooks Amplience created for them. Use the `amplience_webhooks` data source to read the " +
+			"webhooks themselves.",
+		Attributes: map[string]schema.Attribute{
+			"hub_id": schema.StringAttribute{
+				Description: "ID of the Hub to read the search indexes of. Defaults to the `hub_id` of the provider",
+				Optional:    true,
+				Computed:    true,
+				Validators:  []validator.String{utils.NoWhitespace()},
+			},
+			"search_indexes": schema.ListNestedAttribute{
+				Description: "The search indexes of the hub",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "ID of the search index",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Name of the index in Algolia",
+							Computed:    true,
+						},
+						"label": schema.StringAttribute{
+							Description: "Label of the search index",
+							Computed:    true,
+						},
+						"suffix": schema.StringAttribute{
+							Description: "Suffix of the search index",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "Type of the search index, `PRODUCTION` or `STAGING`",
+							Computed:    true,
+						},
+						"parent_id": schema.StringAttribute{
+							Description: "ID of the search index this index is a replica of, or null when it is not " +
+								"a replica",
+							Computed: true,
+						},
+						"replica_count": schema.Int64Attribute{
+							Description: "Number of replicas of the search index",
+							Computed:    true,
+						},
+						"content_types": schema.ListAttribute{
+							Description: "URIs of the content types assigned to the search index",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"webhook_ids": schema.ListAttribute{
+							Description: "IDs of the webhooks Amplience created for the assigned content types",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *searchIndexesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	data := req.ProviderData.(*config.ClientInfo)
+	d.api = data.API
+	d.hubId = data.HubID
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *searchIndexesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config SearchIndexes
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hubID, err := utils.RequireHubID(config.HubID, d.hubId)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("hub_id"), "Missing hub ID", err.Error())
+		return
+	}
+	indexes, err := d.api.AlgoliaIndexGetAll(ctx, hubID)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read search indexes", utils.ErrorDetail(err))
+		return
+	}
+
+	result := &SearchIndexes{
+		HubID:         types.StringValue(hubID),
+		SearchIndexes: []SearchIndex{},
+	}
+	for i := range indexes {
+		assigned, err := d.api.AlgoliaIndexAssignedContentTypes(ctx, hubID, indexes[i].ID)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to read search indexes", utils.ErrorDetail(err))
+			return
+		}
+		result.SearchIndexes = append(result.SearchIndexes, NewSearchIndexFromNative(&indexes[i], assigned))
+	}
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
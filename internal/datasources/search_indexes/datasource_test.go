@@ -0,0 +1,92 @@
+package search_indexes
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/labd/terraform-provider-amplience/internal/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSearchIndexesDataSourceRead(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	ci := testutils.NewClientInfo(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/algolia-search/test-hub/indexes":
+			testutils.WriteJSON(w, http.StatusOK, map[string]interface{}{
+				"_embedded": map[string]interface{}{
+					"indexes": []interface{}{
+						map[string]interface{}{
+							"id": "index-id", "name": "hub.products", "label": "Products", "suffix": "products",
+							"type": "PRODUCTION", "replicaCount": 1,
+						},
+						map[string]interface{}{
+							"id": "replica-id", "parentId": "index-id", "name": "hub.products-price", "label": "By price",
+							"suffix": "products-price", "type": "PRODUCTION",
+						},
+					},
+				},
+				"page": map[string]interface{}{"totalPages": 1},
+			})
+		case "/algolia-search/test-hub/indexes/index-id/assigned-content-types":
+			testutils.WriteJSON(w, http.StatusOK, map[string]interface{}{
+				"_embedded": map[string]interface{}{
+					"assigned-content-types": []interface{}{
+						map[string]interface{}{
+							"contentTypeUri": "https://schema.example.com/product.json",
+							"_links": map[string]interface{}{
+								"webhook": map[string]interface{}{"href": "https://api.example.com/hubs/test-hub/webhooks/webhook-1"},
+							},
+						},
+					},
+				},
+			})
+		case "/algolia-search/test-hub/indexes/replica-id/assigned-content-types":
+			testutils.WriteJSON(w, http.StatusOK, map[string]interface{}{
+				"_embedded": map[string]interface{}{"assigned-content-types": []interface{}{}},
+			})
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			testutils.NotFound(w, r)
+		}
+	}))
+
+	d := &searchIndexesDataSource{}
+	d.Configure(ctx, datasource.ConfigureRequest{ProviderData: ci}, &datasource.ConfigureResponse{})
+	schemaResp := datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	config := tfsdk.Config{
+		Schema: schemaResp.Schema,
+		Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"hub_id":         tftypes.NewValue(tftypes.String, nil),
+			"search_indexes": tftypes.NewValue(objectType.AttributeTypes["search_indexes"], nil),
+		}),
+	}
+	resp := &datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	d.Read(ctx, datasource.ReadRequest{Config: config}, resp)
+	require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+
+	var result SearchIndexes
+	require.False(t, resp.State.Get(ctx, &result).HasError())
+	require.Len(t, result.SearchIndexes, 2)
+
+	index := result.SearchIndexes[0]
+	assert.True(t, index.ParentID.IsNull())
+	assert.Equal(t, int64(1), index.ReplicaCount.ValueInt64())
+	assert.Equal(t, []types.String{types.StringValue("https://schema.example.com/product.json")}, index.ContentTypes)
+	assert.Equal(t, []types.String{types.StringValue("webhook-1")}, index.WebhookIDs)
+
+	replica := result.SearchIndexes[1]
+	assert.Equal(t, "index-id", replica.ParentID.ValueString())
+	assert.Empty(t, replica.ContentTypes)
+}
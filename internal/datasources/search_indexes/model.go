@@ -0,0 +1,51 @@
+package search_indexes
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/labd/amplience-go-sdk/content"
+	"github.com/labd/terraform-provider-amplience/internal/api"
+)
+
+type SearchIndexes struct {
+	HubID         types.String  `tfsdk:"hub_id"`
+	SearchIndexes []SearchIndex `tfsdk:"search_indexes"`
+}
+
+type SearchIndex struct {
+	ID           types.String   `tfsdk:"id"`
+	Name         types.String   `tfsdk:"name"`
+	Label        types.String   `tfsdk:"label"`
+	Suffix       types.String   `tfsdk:"suffix"`
+	Type         types.String   `tfsdk:"type"`
+	ParentID     types.String   `tfsdk:"parent_id"`
+	ReplicaCount types.Int64    `tfsdk:"replica_count"`
+	ContentTypes []types.String `tfsdk:"content_types"`
+	WebhookIDs   []types.String `tfsdk:"webhook_ids"`
+}
+
+// NewSearchIndexFromNative returns a search index with the content types assigned to it and the webhooks Amplience
+// created for them
+func NewSearchIndexFromNative(index *content.AlgoliaIndex, assigned []content.AssignedContentType) SearchIndex {
+	result := SearchIndex{
+		ID:           types.StringValue(index.ID),
+		Name:         types.StringValue(index.Name),
+		Label:        types.StringValue(index.Label),
+		Suffix:       types.StringValue(index.Suffix),
+		Type:         types.StringValue(index.Type),
+		ParentID:     types.StringNull(),
+		ReplicaCount: types.Int64Value(int64(index.ReplicaCount)),
+		ContentTypes: []types.String{},
+		WebhookIDs:   []types.String{},
+	}
+	if index.ParentID != "" {
+		result.ParentID = types.StringValue(index.ParentID)
+	}
+
+	for _, item := range assigned {
+		result.ContentTypes = append(result.ContentTypes, types.StringValue(item.ContentTypeUri))
+		if id := api.WebhookID(item); id != "" {
+			result.WebhookIDs = append(result.WebhookIDs, types.StringValue(id))
+		}
+	}
+	return result
+}
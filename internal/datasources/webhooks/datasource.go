@@ -0,0 +1,172 @@
+package webhooks
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/labd/amplience-go-sdk/content"
+	"github.com/labd/terraform-provider-amplience/internal/api"
+	"github.com/labd/terraform-provider-amplience/internal/config"
+	"github.com/labd/terraform-provider-amplience/internal/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &webhooksDataSource{}
+	_ datasource.DataSourceWithConfigure = &webhooksDataSource{}
+)
+
+// NewWebhooksDataSource is a helper function to simplify the provider implementation.
+func NewWebhooksDataSource() datasource.DataSource {
+	return &webhooksDataSource{}
+}
+
+// webhooksDataSource is the data source implementation.
+type webhooksDataSource struct {
+	api   *api.Client
+	hubId string
+}
+
+// Metadata returns the data source type name.
+func (d *webhooksDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_webhooks"
+}
+
+// Schema defines the schema for the data source.
+func (d *webhooksDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "All webhooks of a hub, including the webhooks Amplience creates for the content types " +
+			"of search indexes. Secrets and headers of the webhooks are not read.",
+		Attributes: map[string]schema.Attribute{
+			"hub_id": schema.StringAttribute{
+				Description: "ID of the Hub to read the webhooks of. Defaults to the `hub_id` of the provider",
+				Optional:    true,
+				Computed:    true,
+				Validators:  []validator.String{utils.NoWhitespace()},
+			},
+			"webhooks": schema.ListNestedAttribute{
+				Description: "The webhooks of the hub",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "ID of the webhook",
+							Computed:    true,
+						},
+						"label": schema.StringAttribute{
+							Description: "Label of the webhook",
+							Computed:    true,
+						},
+						"events": schema.ListAttribute{
+							Description: "Events that trigger the webhook",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"handlers": schema.ListAttribute{
+							Description: "URLs the webhook sends requests to",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"active": schema.BoolAttribute{
+							Description: "Whether the webhook is active",
+							Computed:    true,
+						},
+						"method": schema.StringAttribute{
+							Description: "HTTP method of the requests of the webhook",
+							Computed:    true,
+						},
+						"search_index_id": schema.StringAttribute{
+							Description: "ID of the search index the webhook was created for, or null when the " +
+								"webhook does not belong to a search index",
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *webhooksDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	data := req.ProviderData.(*config.ClientInfo)
+	d.api = data.API
+	d.hubId = data.HubID
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *webhooksDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config Webhooks
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hubID, err := utils.RequireHubID(config.HubID, d.hubId)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("hub_id"), "Missing hub ID", err.Error())
+		return
+	}
+	webhooks, owners, err := d.readWebhooks(ctx, hubID)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read webhooks", utils.ErrorDetail(err))
+		return
+	}
+
+	diags = resp.State.Set(ctx, NewWebhooksFromNative(hubID, webhooks, owners))
+	resp.Diagnostics.Append(diags...)
+}
+
+// readWebhooks returns all webhooks of the hub and the IDs of the search indexes that own them by webhook ID.
+// Webhooks of search indexes that are not listed on the hub are read separately.
+func (d *webhooksDataSource) readWebhooks(ctx context.Context, hubID string) ([]content.Webhook, map[string]string, error) {
+	webhooks, err := d.api.WebhookGetAll(ctx, hubID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	indexes, err := d.api.AlgoliaIndexGetAll(ctx, hubID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	listed := map[string]bool{}
+	for _, webhook := range webhooks {
+		listed[webhook.ID] = true
+	}
+
+	owners := map[string]string{}
+	for _, index := range indexes {
+		assigned, err := d.api.AlgoliaIndexAssignedContentTypes(ctx, hubID, index.ID)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		for _, item := range assigned {
+			id := api.WebhookID(item)
+			if id == "" {
+				continue
+			}
+			owners[id] = index.ID
+			if listed[id] {
+				continue
+			}
+
+			webhook, err := d.api.WebhookGet(ctx, hubID, id)
+			if err != nil {
+				return nil, nil, err
+			}
+			webhooks = append(webhooks, webhook)
+			listed[id] = true
+		}
+	}
+	return webhooks, owners, nil
+}
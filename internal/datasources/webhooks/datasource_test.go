@@ -0,0 +1,105 @@
+package webhooks
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/labd/terraform-provider-amplience/internal/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhooksDataSourceRead(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	webhook := func(id string, handler string) map[string]interface{} {
+		return map[string]interface{}{
+			"id":       id,
+			"label":    "Webhook " + id,
+			"events":   []string{"dynamic-content.content-item.updated"},
+			"handlers": []string{handler},
+			"active":   true,
+			"method":   "POST",
+			"secret":   "secret",
+		}
+	}
+	ci := testutils.NewClientInfo(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/hubs/test-hub/webhooks":
+			testutils.WriteJSON(w, http.StatusOK, map[string]interface{}{
+				"_embedded": map[string]interface{}{
+					"webhooks": []interface{}{
+						webhook("webhook-1", "https://hooks.example.com/publish"),
+						webhook("webhook-2", "https://search.example.com/products"),
+					},
+				},
+				"page": map[string]interface{}{"totalPages": 1},
+			})
+		case "/algolia-search/test-hub/indexes":
+			testutils.WriteJSON(w, http.StatusOK, map[string]interface{}{
+				"_embedded": map[string]interface{}{
+					"indexes": []interface{}{map[string]interface{}{"id": "index-id", "name": "products"}},
+				},
+				"page": map[string]interface{}{"totalPages": 1},
+			})
+		case "/algolia-search/test-hub/indexes/index-id/assigned-content-types":
+			server := "http://" + r.Host
+			testutils.WriteJSON(w, http.StatusOK, map[string]interface{}{
+				"_embedded": map[string]interface{}{
+					"assigned-content-types": []interface{}{
+						map[string]interface{}{
+							"contentTypeUri": "https://schema.example.com/product.json",
+							"_links":         map[string]interface{}{"webhook": map[string]interface{}{"href": server + "/hubs/test-hub/webhooks/webhook-2"}},
+						},
+						map[string]interface{}{
+							"contentTypeUri": "https://schema.example.com/category.json",
+							"_links":         map[string]interface{}{"webhook": map[string]interface{}{"href": server + "/hubs/test-hub/webhooks/webhook-3"}},
+						},
+					},
+				},
+			})
+		case "/hubs/test-hub/webhooks/webhook-3":
+			testutils.WriteJSON(w, http.StatusOK, webhook("webhook-3", "https://search.example.com/categories"))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			testutils.NotFound(w, r)
+		}
+	}))
+
+	d := &webhooksDataSource{}
+	d.Configure(ctx, datasource.ConfigureRequest{ProviderData: ci}, &datasource.ConfigureResponse{})
+	schemaResp := datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	config := tfsdk.Config{
+		Schema: schemaResp.Schema,
+		Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"hub_id":   tftypes.NewValue(tftypes.String, nil),
+			"webhooks": tftypes.NewValue(objectType.AttributeTypes["webhooks"], nil),
+		}),
+	}
+	resp := &datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	d.Read(ctx, datasource.ReadRequest{Config: config}, resp)
+	require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+
+	var result Webhooks
+	require.False(t, resp.State.Get(ctx, &result).HasError())
+	require.Len(t, result.Webhooks, 3)
+
+	assert.Equal(t, "webhook-1", result.Webhooks[0].ID.ValueString())
+	assert.True(t, result.Webhooks[0].SearchIndexID.IsNull())
+	assert.Equal(t, []types.String{types.StringValue("https://hooks.example.com/publish")}, result.Webhooks[0].Handlers)
+	assert.Equal(t, "POST", result.Webhooks[0].Method.ValueString())
+	assert.True(t, result.Webhooks[0].Active.ValueBool())
+
+	assert.Equal(t, "index-id", result.Webhooks[1].SearchIndexID.ValueString())
+	assert.Equal(t, "webhook-3", result.Webhooks[2].ID.ValueString())
+	assert.Equal(t, "index-id", result.Webhooks[2].SearchIndexID.ValueString())
+}
@@ -0,0 +1,56 @@
+package webhooks
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/labd/amplience-go-sdk/content"
+)
+
+type Webhooks struct {
+	HubID    types.String `tfsdk:"hub_id"`
+	Webhooks []Webhook    `tfsdk:"webhooks"`
+}
+
+type Webhook struct {
+	ID            types.String   `tfsdk:"id"`
+	Label         types.String   `tfsdk:"label"`
+	Events        []types.String `tfsdk:"events"`
+	Handlers      []types.String `tfsdk:"handlers"`
+	Active        types.Bool     `tfsdk:"active"`
+	Method        types.String   `tfsdk:"method"`
+	SearchIndexID types.String   `tfsdk:"search_index_id"`
+}
+
+// NewWebhooksFromNative returns the webhooks of a hub. owners contains the ID of the search index that created a
+// webhook by webhook ID.
+func NewWebhooksFromNative(hubID string, webhooks []content.Webhook, owners map[string]string) *Webhooks {
+	result := &Webhooks{
+		HubID:    types.StringValue(hubID),
+		Webhooks: []Webhook{},
+	}
+
+	for _, webhook := range webhooks {
+		searchIndexID := types.StringNull()
+		if owner, ok := owners[webhook.ID]; ok {
+			searchIndexID = types.StringValue(owner)
+		}
+
+		result.Webhooks = append(result.Webhooks, Webhook{
+			ID:            types.StringValue(webhook.ID),
+			Label:         types.StringValue(webhook.Label),
+			Events:        stringValues(webhook.Events),
+			Handlers:      stringValues(webhook.Handlers),
+			Active:        types.BoolValue(webhook.Active),
+			Method:        types.StringValue(webhook.Method),
+			SearchIndexID: searchIndexID,
+		})
+	}
+	return result
+}
+
+func stringValues(values []string) []types.String {
+	result := []types.String{}
+	for _, value := range values {
+		result = append(result, types.StringValue(value))
+	}
+	return result
+}
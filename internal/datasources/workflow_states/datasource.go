@@ -0,0 +1,123 @@
+package workflow_states
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/labd/terraform-provider-amplience/internal/api"
+	"github.com/labd/terraform-provider-amplience/internal/config"
+	"github.com/labd/terraform-provider-amplience/internal/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &workflowStatesDataSource{}
+	_ datasource.DataSourceWithConfigure = &workflowStatesDataSource{}
+)
+
+// NewWorkflowStatesDataSource is a helper function to simplify the provider implementation.
+func NewWorkflowStatesDataSource() datasource.DataSource {
+	return &workflowStatesDataSource{}
+}
+
+// workflowStatesDataSource is the data source implementation.
+type workflowStatesDataSource struct {
+	api   *api.Client
+	hubId string
+}
+
+// Metadata returns the data source type name.
+func (d *workflowStatesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workflow_states"
+}
+
+// Schema defines the schema for the data source.
+func (d *workflowStatesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "All editorial workflow states of a hub. Use `ids` to refer to a workflow state by its " +
+			"label.",
+		Attributes: map[string]schema.Attribute{
+			"hub_id": schema.StringAttribute{
+				Description: "ID of the Hub to read the workflow states of. Defaults to the `hub_id` of the provider",
+				Optional:    true,
+				Computed:    true,
+				Validators:  []validator.String{utils.NoWhitespace()},
+			},
+			"workflow_states": schema.ListNestedAttribute{
+				Description: "The workflow states of the hub",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "ID of the workflow state",
+							Computed:    true,
+						},
+						"label": schema.StringAttribute{
+							Description: "Label of the workflow state",
+							Computed:    true,
+						},
+						"color": schema.StringAttribute{
+							Description: "Color of the workflow state",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"ids": schema.MapAttribute{
+				Description: "The IDs of the workflow states by label. Labels used by more than one workflow state " +
+					"are left out",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *workflowStatesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	data := req.ProviderData.(*config.ClientInfo)
+	d.api = data.API
+	d.hubId = data.HubID
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *workflowStatesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config WorkflowStates
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hubID, err := utils.RequireHubID(config.HubID, d.hubId)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("hub_id"), "Missing hub ID", err.Error())
+		return
+	}
+	states, err := d.api.WorkflowStateGetAll(ctx, hubID)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read workflow states", utils.ErrorDetail(err))
+		return
+	}
+
+	result, duplicates := NewWorkflowStatesFromNative(hubID, states)
+	if len(duplicates) > 0 {
+		resp.Diagnostics.AddWarning(
+			"Duplicate workflow state labels",
+			fmt.Sprintf("The labels %s are used by more than one workflow state, so they are left out of ids.",
+				strings.Join(duplicates, ", ")),
+		)
+	}
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
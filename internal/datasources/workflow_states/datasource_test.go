@@ -0,0 +1,69 @@
+package workflow_states
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/labd/terraform-provider-amplience/internal/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkflowStatesDataSourceRead(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	pages := [][]map[string]interface{}{
+		{
+			{"id": "draft-id", "label": "Draft", "color": "rgb(200,200,200)"},
+			{"id": "review-id", "label": "In review", "color": "rgb(255,165,0)"},
+		},
+		{
+			{"id": "approved-id", "label": "Approved", "color": "rgb(0,128,0)"},
+			{"id": "other-review-id", "label": "In review", "color": "rgb(255,0,0)"},
+		},
+	}
+	ci := testutils.NewClientInfo(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/hubs/test-hub/workflow-states", r.URL.Path)
+		page := 0
+		if r.URL.Query().Get("page") == "1" {
+			page = 1
+		}
+		testutils.WriteJSON(w, http.StatusOK, map[string]interface{}{
+			"_embedded": map[string]interface{}{"workflow-states": pages[page]},
+			"page":      map[string]interface{}{"totalPages": 2, "number": page},
+		})
+	}))
+
+	d := &workflowStatesDataSource{}
+	d.Configure(ctx, datasource.ConfigureRequest{ProviderData: ci}, &datasource.ConfigureResponse{})
+	schemaResp := datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+
+	config := tfsdk.Config{
+		Schema: schemaResp.Schema,
+		Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), map[string]tftypes.Value{
+			"hub_id":          tftypes.NewValue(tftypes.String, nil),
+			"workflow_states": tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object).AttributeTypes["workflow_states"], nil),
+			"ids":             tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+		}),
+	}
+	resp := &datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	d.Read(ctx, datasource.ReadRequest{Config: config}, resp)
+	require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+
+	require.Len(t, resp.Diagnostics.Warnings(), 1)
+	assert.Contains(t, resp.Diagnostics.Warnings()[0].Detail(), "In review")
+
+	var result WorkflowStates
+	require.False(t, resp.State.Get(ctx, &result).HasError())
+	assert.Equal(t, testutils.HubID, result.HubID.ValueString())
+	assert.Len(t, result.WorkflowStates, 4)
+	assert.Equal(t, types.StringValue("Approved"), result.WorkflowStates[2].Label)
+	assert.Equal(t, map[string]string{"Draft": "draft-id", "Approved": "approved-id"}, result.IDs)
+}
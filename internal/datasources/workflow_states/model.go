@@ -0,0 +1,50 @@
+package workflow_states
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/labd/terraform-provider-amplience/internal/api"
+)
+
+type WorkflowStates struct {
+	HubID          types.String      `tfsdk:"hub_id"`
+	WorkflowStates []WorkflowState   `tfsdk:"workflow_states"`
+	IDs            map[string]string `tfsdk:"ids"`
+}
+
+type WorkflowState struct {
+	ID    types.String `tfsdk:"id"`
+	Label types.String `tfsdk:"label"`
+	Color types.String `tfsdk:"color"`
+}
+
+// NewWorkflowStatesFromNative returns the workflow states of a hub and the labels that are used by more than one
+// workflow state. Those labels are left out of IDs.
+func NewWorkflowStatesFromNative(hubID string, states []api.WorkflowState) (*WorkflowStates, []string) {
+	result := &WorkflowStates{
+		HubID:          types.StringValue(hubID),
+		WorkflowStates: []WorkflowState{},
+		IDs:            map[string]string{},
+	}
+
+	count := map[string]int{}
+	for _, state := range states {
+		result.WorkflowStates = append(result.WorkflowStates, WorkflowState{
+			ID:    types.StringValue(state.ID),
+			Label: types.StringValue(state.Label),
+			Color: types.StringValue(state.Color),
+		})
+		result.IDs[state.Label] = state.ID
+		count[state.Label]++
+	}
+
+	var duplicates []string
+	for _, state := range states {
+		if count[state.Label] > 1 {
+			if _, ok := result.IDs[state.Label]; ok {
+				duplicates = append(duplicates, state.Label)
+				delete(result.IDs, state.Label)
+			}
+		}
+	}
+	return result, duplicates
+}
@@ -0,0 +1,83 @@
+package render_webhook_payload
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/labd/terraform-provider-amplience/internal/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces
+var (
+	_ function.Function = &renderWebhookPayloadFunction{}
+)
+
+// NewRenderWebhookPayloadFunction is a helper function to simplify the provider implementation.
+func NewRenderWebhookPayloadFunction() function.Function {
+	return &renderWebhookPayloadFunction{}
+}
+
+// renderWebhookPayloadFunction renders the Handlebars template of a webhook custom payload, so payloads can be tested
+// without sending events from Amplience
+type renderWebhookPayloadFunction struct{}
+
+// Metadata returns the function name
+func (f *renderWebhookPayloadFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "render_webhook_payload"
+}
+
+// Definition defines the parameters and return type of the function
+func (f *renderWebhookPayloadFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Render the custom payload of a webhook",
+		MarkdownDescription: "Renders a Handlebars template, such as the `custom_payload` value of an `amplience_webhook` or " +
+			"the `webhook_custom_payload` value of an `amplience_search_index`, with a sample event. This can be " +
+			"used to test custom payloads with `terraform test`. Only the built-in Handlebars helpers are available.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "template",
+				Description: "The Handlebars template of the payload",
+			},
+			function.StringParameter{
+				Name: "event",
+				Description: "JSON of the sample event the template is rendered with, for example " +
+					"`jsonencode({ payload = { id = \"abc\" } })`",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run renders the template with the event
+func (f *renderWebhookPayloadFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var template, event string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &template, &event))
+	if resp.Error != nil {
+		return
+	}
+
+	if err := utils.ValidateHandlebars(template); err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Invalid Handlebars template: %s", err))
+		return
+	}
+
+	decoder := json.NewDecoder(strings.NewReader(event))
+	decoder.UseNumber()
+	var data interface{}
+	if err := decoder.Decode(&data); err != nil {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("Invalid event JSON: %s", err))
+		return
+	}
+
+	result, err := utils.RenderHandlebars(template, data)
+	if err != nil {
+		resp.Error = function.NewFuncError(fmt.Sprintf("Unable to render the template: %s", err))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
@@ -0,0 +1,63 @@
+package render_webhook_payload
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRenderWebhookPayloadFunctionRun(t *testing.T) {
+	t.Parallel()
+	tcs := []struct {
+		Name     string
+		Template string
+		Event    string
+		Expected string
+		Error    *function.FuncError
+	}{
+		{
+			Name:     "Renders the template",
+			Template: `{"id": "{{payload.id}}", "version": {{payload.version}}{{#if payload.label}}, "label": "{{payload.label}}"{{/if}} }`,
+			Event:    `{"name": "dynamic-content.content-item.updated", "payload": {"id": "abc", "version": 12345678901234567890}}`,
+			Expected: `{"id": "abc", "version": 12345678901234567890 }`,
+		},
+		{
+			Name:     "Invalid template",
+			Template: "{{#if payload.id}}\n{{/each}}",
+			Event:    `{}`,
+			Error:    function.NewArgumentFuncError(0, "Invalid Handlebars template: line 2, column 4: if doesn't match each"),
+		},
+		{
+			Name:     "Invalid event",
+			Template: "{{payload.id}}",
+			Event:    `{"payload": }`,
+			Error:    function.NewArgumentFuncError(1, "Invalid event JSON: invalid character '}' looking for beginning of value"),
+		},
+	}
+
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+			req := function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{
+					types.StringValue(tc.Template),
+					types.StringValue(tc.Event),
+				}),
+			}
+			resp := &function.RunResponse{Result: function.NewResultData(types.StringUnknown())}
+
+			NewRenderWebhookPayloadFunction().Run(ctx, req, resp)
+
+			assert.Equal(t, tc.Error, resp.Error)
+			if tc.Error == nil {
+				assert.Equal(t, function.NewResultData(types.StringValue(tc.Expected)), resp.Result)
+			}
+		})
+	}
+}
@@ -2,26 +2,40 @@ package provider
 
 import (
 	"context"
-	"fmt"
-	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
-	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/labd/amplience-go-sdk/content"
-	"github.com/labd/terraform-provider-amplience/amplience"
+	"github.com/labd/terraform-provider-amplience/internal/config"
+	"github.com/labd/terraform-provider-amplience/internal/datasources/content_repositories"
+	"github.com/labd/terraform-provider-amplience/internal/datasources/content_type"
+	contenttypeschemadatasource "github.com/labd/terraform-provider-amplience/internal/datasources/content_type_schema"
+	"github.com/labd/terraform-provider-amplience/internal/datasources/content_type_schemas"
+	"github.com/labd/terraform-provider-amplience/internal/datasources/content_types"
+	hubdatasource "github.com/labd/terraform-provider-amplience/internal/datasources/hub"
+	"github.com/labd/terraform-provider-amplience/internal/datasources/search_indexes"
+	"github.com/labd/terraform-provider-amplience/internal/datasources/webhooks"
+	"github.com/labd/terraform-provider-amplience/internal/datasources/workflow_states"
+	"github.com/labd/terraform-provider-amplience/internal/functions/render_webhook_payload"
+	"github.com/labd/terraform-provider-amplience/internal/resources/content_item"
+	"github.com/labd/terraform-provider-amplience/internal/resources/content_type_schema"
+	"github.com/labd/terraform-provider-amplience/internal/resources/edition"
+	"github.com/labd/terraform-provider-amplience/internal/resources/event"
+	"github.com/labd/terraform-provider-amplience/internal/resources/extension"
+	"github.com/labd/terraform-provider-amplience/internal/resources/folder"
 	"github.com/labd/terraform-provider-amplience/internal/resources/hub"
-	"github.com/labd/terraform-provider-amplience/internal/utils"
-	"net/http"
-	"os"
+	"github.com/labd/terraform-provider-amplience/internal/resources/search_index_replica"
+	"github.com/labd/terraform-provider-amplience/internal/resources/workflow_state"
 )
 
 // Ensure the implementation satisfies the expected interfaces
 var (
-	_ provider.Provider = &amplienceProvider{}
+	_ provider.Provider              = &amplienceProvider{}
+	_ provider.ProviderWithFunctions = &amplienceProvider{}
 )
 
 func New(version string) provider.Provider {
@@ -41,6 +55,8 @@ type amplienceProviderModel struct {
 	ContentApiUrl types.String `tfsdk:"content_api_url"`
 	AuthUrl       types.String `tfsdk:"auth_url"`
 	HubID         types.String `tfsdk:"hub_id"`
+	MaxRetries    types.Int64  `tfsdk:"max_retries"`
+	RetryMaxWait  types.Int64  `tfsdk:"retry_max_wait"`
 }
 
 // Metadata returns the provider type name.
@@ -48,127 +64,58 @@ func (p *amplienceProvider) Metadata(_ context.Context, _ provider.MetadataReque
 	resp.TypeName = "amplience"
 }
 
-// Schema returns a Terraform.ResourceProvider.
+// Schema returns a Terraform.ResourceProvider. The attributes are shared with the SDKv2 provider, see
+// config.Attributes.
 func (p *amplienceProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
-	resp.Schema = schema.Schema{
-		Attributes: map[string]schema.Attribute{
-			"client_id": schema.StringAttribute{
-				Description: "The OAuth Client ID for the Amplience management API https://amplience_provider.com/docs/api/dynamic-content/management/index.html#section/Authentication",
-				Required:    true,
-				Sensitive:   true,
-			},
-			"client_secret": schema.StringAttribute{
-				Description: "The OAuth Client Secret for Amplience management API. https://amplience_provider.com/docs/api/dynamic-content/management/index.html#section/Authentication",
-				Required:    true,
-				Sensitive:   true,
-			},
-			"content_api_url": schema.StringAttribute{
-				Description: "The base URL path for the Amplience Content API",
+	attributes := map[string]schema.Attribute{}
+	for _, attribute := range config.Attributes {
+		switch attribute.Type {
+		case config.TypeString:
+			attributes[attribute.Name] = schema.StringAttribute{
+				Description: attribute.FullDescription(),
 				Optional:    true,
-				Sensitive:   false,
-			},
-			"auth_url": schema.StringAttribute{
-				Description: "The Amplience authentication URL",
+				Sensitive:   attribute.Sensitive,
+			}
+		case config.TypeInt:
+			attributes[attribute.Name] = schema.Int64Attribute{
+				Description: attribute.FullDescription(),
 				Optional:    true,
-				Sensitive:   false,
-			},
-			"hub_id": schema.StringAttribute{
-				Description: "ID of the Hub to manage",
-				Required:    true,
-				Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
-			},
-		},
+				Sensitive:   attribute.Sensitive,
+			}
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Attributes: attributes,
 	}
 }
 
 func (p *amplienceProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
-	var config amplienceProviderModel
+	var model amplienceProviderModel
 
-	diags := req.Config.Get(ctx, &config)
+	diags := req.Config.Get(ctx, &model)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	var clientID string
-	if config.ClientID.IsUnknown() || config.ClientID.IsNull() {
-		clientID = os.Getenv("AMPLIENCE_CLIENT_ID")
-	} else {
-		clientID = config.ClientID.ValueString()
-	}
-
-	if clientID == "" {
-		resp.Diagnostics.AddAttributeError(
-			path.Root("client_id"),
-			"Unknown Amplience Client ID",
-			"Unknown Amplience Client ID. Please provide a valid client ID.",
-		)
-	}
-
-	var clientSecret string
-	if config.ClientSecret.IsUnknown() || config.ClientSecret.IsNull() {
-		clientSecret = os.Getenv("AMPLIENCE_CLIENT_SECRET")
-	} else {
-		clientSecret = config.ClientSecret.ValueString()
-	}
-
-	if clientSecret == "" {
-		resp.Diagnostics.AddAttributeError(
-			path.Root("client_secret"),
-			"Unknown Amplience Client Secret",
-			"Unknown Amplience Client Secret. Please provide a valid client secret",
-		)
-	}
-
-	var hubId string
-	if config.HubID.IsUnknown() || config.HubID.IsNull() {
-		hubId = os.Getenv("AMPLIENCE_HUB_ID")
-	} else {
-		hubId = config.HubID.ValueString()
-	}
-
-	if hubId == "" {
-		resp.Diagnostics.AddAttributeError(
-			path.Root("hub_id"),
-			"Unknown Amplience Hub ID",
-			"Unknown Amplience Hub ID. Please provide a valid hub ID.",
-		)
-	}
-
-	var contentApiUrl string
-	if config.ContentApiUrl.IsUnknown() || config.ContentApiUrl.IsNull() {
-		contentApiUrl = utils.GetEnv(
-			"AMPLIENCE_CONTENT_API_URL",
-			"https://api.amplience.net/v2/content",
-		)
-	} else {
-		contentApiUrl = config.ContentApiUrl.ValueString()
-	}
-
-	var authUrl string
-	if config.AuthUrl.IsUnknown() || config.AuthUrl.IsNull() {
-		authUrl = utils.GetEnv(
-			"AMPLIENCE_AUTH_URL",
-			"https://auth.amplience.net/oauth/token",
-		)
-	} else {
-		authUrl = config.AuthUrl.ValueString()
+	cfg, errs := config.Resolve(config.RawConfig{
+		ClientID:      stringPointer(model.ClientID),
+		ClientSecret:  stringPointer(model.ClientSecret),
+		ContentAPIURL: stringPointer(model.ContentApiUrl),
+		AuthURL:       stringPointer(model.AuthUrl),
+		HubID:         stringPointer(model.HubID),
+		MaxRetries:    int64Pointer(model.MaxRetries),
+		RetryMaxWait:  int64Pointer(model.RetryMaxWait),
+	})
+	for _, err := range errs {
+		resp.Diagnostics.AddAttributeError(path.Root(err.Attribute), err.Summary, err.Detail)
 	}
-
-	httpClient := &http.Client{
-		Transport: &utils.UserAgentTransport{
-			UserAgent: fmt.Sprintf("terraform-provider-amplience/%s", p.version),
-			Transport: http.DefaultTransport,
-		},
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	client, err := content.NewClient(&content.ClientConfig{
-		ClientID:     clientID,
-		ClientSecret: clientSecret,
-		URL:          contentApiUrl,
-		AuthURL:      authUrl,
-		HTTPClient:   httpClient,
-	})
+	data, err := config.NewClientInfo(cfg, p.version)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to create client",
@@ -177,22 +124,59 @@ func (p *amplienceProvider) Configure(ctx context.Context, req provider.Configur
 		return
 	}
 
-	data := &amplience.ClientInfo{
-		Client: client,
-		HubID:  hubId,
-	}
 	resp.DataSourceData = data
 	resp.ResourceData = data
 }
 
 // DataSources defines the data sources implemented in the provider.
 func (p *amplienceProvider) DataSources(_ context.Context) []func() datasource.DataSource {
-	return []func() datasource.DataSource{}
+	return []func() datasource.DataSource{
+		workflow_states.NewWorkflowStatesDataSource,
+		hubdatasource.NewHubDataSource,
+		content_repositories.NewContentRepositoriesDataSource,
+		content_type.NewContentTypeDataSource,
+		content_types.NewContentTypesDataSource,
+		contenttypeschemadatasource.NewContentTypeSchemaDataSource,
+		content_type_schemas.NewContentTypeSchemasDataSource,
+		webhooks.NewWebhooksDataSource,
+		search_indexes.NewSearchIndexesDataSource,
+	}
 }
 
 // Resources defines the resources implemented in the provider.
 func (p *amplienceProvider) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		hub.NewHubResource,
+		content_type_schema.NewContentTypeSchemaResource,
+		content_item.NewContentItemResource,
+		folder.NewFolderResource,
+		extension.NewExtensionResource,
+		workflow_state.NewWorkflowStateResource,
+		event.NewEventResource,
+		edition.NewEditionResource,
+		search_index_replica.NewSearchIndexReplicaResource,
+	}
+}
+
+// Functions defines the provider-defined functions implemented in the provider.
+func (p *amplienceProvider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		render_webhook_payload.NewRenderWebhookPayloadFunction,
+	}
+}
+
+// stringPointer returns nil for null and unknown values, so the environment variable or default is used instead
+func stringPointer(value types.String) *string {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+	return value.ValueStringPointer()
+}
+
+// int64Pointer returns nil for null and unknown values, so the environment variable or default is used instead
+func int64Pointer(value types.Int64) *int64 {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
 	}
+	return value.ValueInt64Pointer()
 }
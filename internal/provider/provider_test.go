@@ -0,0 +1,36 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-mux/tf5to6server"
+	"github.com/hashicorp/terraform-plugin-mux/tf6muxserver"
+	"github.com/labd/terraform-provider-amplience/amplience"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestMuxServerSchema verifies the SDKv2 and framework providers can be served together. The mux server rejects
+// provider schemas that differ between the two, and resource types or data sources that are defined twice.
+func TestMuxServerSchema(t *testing.T) {
+	ctx := context.Background()
+
+	legacyServer, err := tf5to6server.UpgradeServer(ctx, amplience.Provider("testing").GRPCProvider)
+	require.NoError(t, err)
+
+	muxServer, err := tf6muxserver.NewMuxServer(ctx,
+		providerserver.NewProtocol6(New("testing")),
+		func() tfprotov6.ProviderServer { return legacyServer },
+	)
+	require.NoError(t, err)
+
+	resp, err := muxServer.ProviderServer().GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	require.NoError(t, err)
+
+	for _, d := range resp.Diagnostics {
+		assert.NotEqual(t, tfprotov6.DiagnosticSeverityError, d.Severity, "%s: %s", d.Summary, d.Detail)
+	}
+}
@@ -0,0 +1,116 @@
+package content_item
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/labd/terraform-provider-amplience/internal/api"
+)
+
+// metaKey is the property of the body that holds the metadata of a content item, such as its schema and delivery key.
+// It is managed by the provider using the other attributes of the resource.
+const metaKey = "_meta"
+
+type ContentItem struct {
+	ID           types.String         `tfsdk:"id"`
+	HubID        types.String         `tfsdk:"hub_id"`
+	RepositoryID types.String         `tfsdk:"repository_id"`
+	FolderID     types.String         `tfsdk:"folder_id"`
+	SchemaID     types.String         `tfsdk:"schema_id"`
+	Label        types.String         `tfsdk:"label"`
+	Locale       types.String         `tfsdk:"locale"`
+	DeliveryKey  types.String         `tfsdk:"delivery_key"`
+	Body         jsontypes.Normalized `tfsdk:"body"`
+	Version      types.Int64          `tfsdk:"version"`
+}
+
+// NewContentItemFromNative converts a content item of the API. The _meta property is removed from the body and
+// stored in the schema_id and delivery_key attributes instead.
+func NewContentItemFromNative(hubID string, item *api.ContentItem) (*ContentItem, error) {
+	body, err := decodeObject(item.Body)
+	if err != nil {
+		return nil, fmt.Errorf("the body of content item %s is not a JSON object: %w", item.ID, err)
+	}
+
+	meta, _ := body[metaKey].(map[string]interface{})
+	delete(body, metaKey)
+
+	raw, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &ContentItem{
+		ID:           types.StringValue(item.ID),
+		HubID:        types.StringValue(hubID),
+		RepositoryID: types.StringValue(item.ContentRepositoryID),
+		FolderID:     optionalString(item.FolderID),
+		SchemaID:     types.StringNull(),
+		Label:        types.StringValue(item.Label),
+		Locale:       optionalString(item.Locale),
+		DeliveryKey:  types.StringNull(),
+		Body:         jsontypes.NewNormalizedValue(string(raw)),
+		Version:      types.Int64Value(int64(item.Version)),
+	}
+	if schema, ok := meta["schema"].(string); ok {
+		result.SchemaID = types.StringValue(schema)
+	}
+	if deliveryKey, ok := meta["deliveryKey"].(string); ok && deliveryKey != "" {
+		result.DeliveryKey = types.StringValue(deliveryKey)
+	}
+	return result, nil
+}
+
+// ToInput returns the input to create or update the content item, with the _meta property added to the body
+func (c *ContentItem) ToInput() (api.ContentItemInput, error) {
+	body, err := decodeObject([]byte(c.Body.ValueString()))
+	if err != nil {
+		return api.ContentItemInput{}, err
+	}
+
+	meta := map[string]interface{}{
+		"schema": c.SchemaID.ValueString(),
+		"name":   c.Label.ValueString(),
+	}
+	if c.DeliveryKey.ValueString() != "" {
+		meta["deliveryKey"] = c.DeliveryKey.ValueString()
+	}
+	body[metaKey] = meta
+
+	raw, err := json.Marshal(body)
+	if err != nil {
+		return api.ContentItemInput{}, err
+	}
+
+	return api.ContentItemInput{
+		Body:     raw,
+		Label:    c.Label.ValueString(),
+		FolderID: c.FolderID.ValueString(),
+		Locale:   c.Locale.ValueString(),
+	}, nil
+}
+
+// decodeObject decodes a JSON object, keeping numbers as they are written
+func decodeObject(data []byte) (map[string]interface{}, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var body map[string]interface{}
+	if err := decoder.Decode(&body); err != nil {
+		return nil, err
+	}
+	if body == nil {
+		return nil, fmt.Errorf("expected a JSON object, got null")
+	}
+	return body, nil
+}
+
+func optionalString(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
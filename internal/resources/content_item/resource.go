@@ -0,0 +1,350 @@
+package content_item
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/labd/amplience-go-sdk/content"
+	"github.com/labd/terraform-provider-amplience/internal/api"
+	"github.com/labd/terraform-provider-amplience/internal/config"
+	"github.com/labd/terraform-provider-amplience/internal/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &contentItemResource{}
+	_ resource.ResourceWithConfigure      = &contentItemResource{}
+	_ resource.ResourceWithImportState    = &contentItemResource{}
+	_ resource.ResourceWithValidateConfig = &contentItemResource{}
+)
+
+// NewContentItemResource is a helper function to simplify the provider implementation.
+func NewContentItemResource() resource.Resource {
+	return &contentItemResource{}
+}
+
+// contentItemResource is the resource implementation.
+type contentItemResource struct {
+	client *content.Client
+	api    *api.Client
+	hubId  string
+}
+
+// Metadata returns the resource type name.
+func (r *contentItemResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_content_item"
+}
+
+// Schema defines the schema for the resource.
+func (r *contentItemResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "A content item in a content repository, such as the global navigation or feature flags " +
+			"of a site. The body is validated against the content type schema before it is sent to Amplience. " +
+			"Updates fail when the content item was changed outside of Terraform since it was last read, and " +
+			"destroying the resource archives the content item.\n" +
+			"For more info see [Amplience Content Item Docs](https://amplience.com/developers/docs/apis/content-management-reference/#tag/Content-Items)",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the content item",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"hub_id": utils.HubIDAttribute(),
+			"repository_id": schema.StringAttribute{
+				Description: "ID of the content repository to create the content item in",
+				Required:    true,
+				Validators:  []validator.String{utils.NoWhitespace()},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"folder_id": schema.StringAttribute{
+				Description: "ID of the folder to create the content item in. Moving the content item to another " +
+					"folder is done in place, removing the folder replaces the content item",
+				Optional:   true,
+				Validators: []validator.String{utils.NoWhitespace()},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(
+						func(_ context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+							resp.RequiresReplace = req.PlanValue.IsNull()
+						},
+						"Removing the folder replaces the content item",
+						"Removing the folder replaces the content item",
+					),
+				},
+			},
+			"schema_id": schema.StringAttribute{
+				Description: "ID of the content type schema of the content item, which is set as `_meta.schema` " +
+					"in the body",
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"label": schema.StringAttribute{
+				Description: "Label of the content item",
+				Required:    true,
+			},
+			"locale": schema.StringAttribute{
+				Description: "Locale of the content item, for example `en-GB`",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"delivery_key": schema.StringAttribute{
+				Description: "Delivery key to retrieve the content item with from the Content Delivery API, which " +
+					"is set as `_meta.deliveryKey` in the body",
+				Optional: true,
+			},
+			"body": schema.StringAttribute{
+				Description: "A JSON object with the content of the content item. The `_meta` property is managed " +
+					"using the other attributes and must not be set",
+				Required:   true,
+				CustomType: jsontypes.NormalizedType{},
+			},
+			"version": schema.Int64Attribute{
+				Description: "Version of the content item, which changes on every update",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// ValidateConfig checks the body during validate and plan. Validating the body against the content type schema
+// needs the API, so that is done during apply.
+func (r *contentItemResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config ContentItem
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Body.IsNull() || config.Body.IsUnknown() {
+		return
+	}
+
+	if err := validateBody(config.Body.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("body"), "Invalid content item body", err.Error())
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *contentItemResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	data := req.ProviderData.(*config.ClientInfo)
+	r.client = data.Client
+	r.api = data.API
+	r.hubId = data.HubID
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *contentItemResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ContentItem
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hubID, err := utils.RequireHubID(plan.HubID, r.hubId)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("hub_id"), "Missing hub ID", err.Error())
+		return
+	}
+	input, diags := r.validatedInput(ctx, hubID, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	instance, err := r.api.ContentItemCreate(ctx, plan.RepositoryID.ValueString(), input)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create content item", utils.ErrorDetail(err))
+		return
+	}
+
+	result, err := NewContentItemFromNative(hubID, &instance)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read content item", err.Error())
+		return
+	}
+	result.SchemaID = plan.SchemaID
+	result.DeliveryKey = plan.DeliveryKey
+	result.Body = plan.Body
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+// validatedInput returns the input for the content item after validating its body against the content type schema
+func (r *contentItemResource) validatedInput(ctx context.Context, hubID string, plan *ContentItem) (api.ContentItemInput, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	input, err := plan.ToInput()
+	if err != nil {
+		diags.AddAttributeError(path.Root("body"), "Invalid content item body", err.Error())
+		return input, diags
+	}
+
+	schemaID := plan.SchemaID.ValueString()
+	contentTypeSchema, err := r.api.ContentTypeSchemaFindBySchemaID(ctx, hubID, schemaID)
+	if err != nil {
+		diags.AddAttributeError(path.Root("schema_id"), "Failed to read content type schema", utils.ErrorDetail(err))
+		return input, diags
+	}
+
+	messages, err := validateAgainstSchema(schemaID, contentTypeSchema.Body, input.Body)
+	if err != nil {
+		diags.AddAttributeError(path.Root("schema_id"), "Failed to validate content item body", err.Error())
+		return input, diags
+	}
+	if len(messages) > 0 {
+		diags.AddAttributeError(path.Root("body"), "Content item body does not match its schema",
+			fmt.Sprintf("The body does not match the content type schema %s:\n- %s", schemaID,
+				strings.Join(messages, "\n- ")))
+		return input, diags
+	}
+	return input, diags
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *contentItemResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ContentItem
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	instance, err := r.api.ContentItemGet(ctx, state.ID.ValueString())
+	if err != nil {
+		if utils.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Failed to read content item", utils.ErrorDetail(err))
+		return
+	}
+
+	// Content items can only be archived, so an archived item is gone
+	if utils.IsArchived(instance.Status) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	current, err := NewContentItemFromNative(utils.HubID(state.HubID, r.hubId), &instance)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read content item", err.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, current)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success. The version in the state is sent with
+// the update, so changes made outside of Terraform since the last refresh are not overwritten.
+func (r *contentItemResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var state ContentItem
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var plan ContentItem
+	diags = req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hubID := utils.HubID(state.HubID, r.hubId)
+	input, diags := r.validatedInput(ctx, hubID, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	input.Version = int(state.Version.ValueInt64())
+
+	instance, err := r.api.ContentItemUpdate(ctx, state.ID.ValueString(), input)
+	if err != nil {
+		if utils.IsConflict(err) {
+			resp.Diagnostics.AddError(
+				"Content item was changed outside of Terraform",
+				fmt.Sprintf("Content item %s was changed since version %d was read. Run terraform plan again to "+
+					"review the changes before applying them.", state.ID.ValueString(), state.Version.ValueInt64()),
+			)
+			return
+		}
+		resp.Diagnostics.AddError("Failed to update content item", utils.ErrorDetail(err))
+		return
+	}
+
+	result, err := NewContentItemFromNative(hubID, &instance)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read content item", err.Error())
+		return
+	}
+	result.SchemaID = plan.SchemaID
+	result.DeliveryKey = plan.DeliveryKey
+	result.Body = plan.Body
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete archives the content item, since the Amplience API does not support deleting content items.
+func (r *contentItemResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ContentItem
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	instance, err := r.api.ContentItemGet(ctx, state.ID.ValueString())
+	if err != nil {
+		if utils.IsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError("Failed to read content item", utils.ErrorDetail(err))
+		return
+	}
+
+	if !utils.IsArchived(instance.Status) {
+		_, err = r.api.ContentItemArchive(ctx, instance.ID, instance.Version)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to archive content item", utils.ErrorDetail(err))
+			return
+		}
+	}
+}
+
+// ImportState imports a content item using either its ID or a <hub_id>:<id> ID
+func (r *contentItemResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	prefix, id := utils.ParseID(req.ID)
+	hubID, err := utils.RequireHubID(types.StringValue(prefix), r.hubId)
+	if err != nil {
+		resp.Diagnostics.AddError("Missing hub ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("hub_id"), types.StringValue(hubID))...)
+}
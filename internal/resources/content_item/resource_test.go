@@ -0,0 +1,253 @@
+package content_item
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/labd/terraform-provider-amplience/internal/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestResource(t *testing.T, handler http.Handler) (*contentItemResource, resource.SchemaResponse) {
+	ctx := context.Background()
+	ci := testutils.NewClientInfo(t, handler)
+
+	r := &contentItemResource{}
+	r.Configure(ctx, resource.ConfigureRequest{ProviderData: ci}, &resource.ConfigureResponse{})
+
+	schemaResp := resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	return r, schemaResp
+}
+
+func newTestState(t *testing.T, schemaResp resource.SchemaResponse, value *ContentItem) tfsdk.State {
+	ctx := context.Background()
+	state := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	diags := state.Set(ctx, value)
+	require.False(t, diags.HasError(), "%v", diags)
+	return state
+}
+
+func testContentItem(body string) *ContentItem {
+	return &ContentItem{
+		ID:           types.StringValue("item-id"),
+		HubID:        types.StringValue(testutils.HubID),
+		RepositoryID: types.StringValue("repository-id"),
+		FolderID:     types.StringNull(),
+		SchemaID:     types.StringValue(testSchemaID),
+		Label:        types.StringValue("Main navigation"),
+		Locale:       types.StringValue("en-GB"),
+		DeliveryKey:  types.StringValue("navigation/main"),
+		Body:         jsontypes.NewNormalizedValue(body),
+		Version:      types.Int64Value(3),
+	}
+}
+
+func testContentItemResponse(body string, version int, status string) map[string]interface{} {
+	return map[string]interface{}{
+		"id":                  "item-id",
+		"contentRepositoryId": "repository-id",
+		"label":               "Main navigation",
+		"locale":              "en-GB",
+		"version":             version,
+		"status":              status,
+		"body":                json.RawMessage(body),
+	}
+}
+
+// writeTestSchemas responds with the content type schemas of the hub, which are used to validate the body
+func writeTestSchemas(w http.ResponseWriter) {
+	testutils.WriteJSON(w, http.StatusOK, map[string]interface{}{
+		"_embedded": map[string]interface{}{"content-type-schemas": []interface{}{
+			map[string]interface{}{"id": "schema-id", "schemaId": testSchemaID, "body": testSchemaBody, "status": "ACTIVE"},
+		}},
+		"page": map[string]interface{}{"totalPages": 1},
+	})
+}
+
+func TestContentItemResourceCreate(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	var requests []string
+	r, schemaResp := newTestResource(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/hubs/test-hub/content-type-schemas":
+			writeTestSchemas(w)
+		case r.Method == http.MethodPost && r.URL.Path == "/content-repositories/repository-id/content-items":
+			requests = append(requests, r.Method+" "+r.URL.Path+" "+string(body))
+			var input map[string]json.RawMessage
+			require.NoError(t, json.Unmarshal(body, &input))
+			testutils.WriteJSON(w, http.StatusCreated, testContentItemResponse(string(input["body"]), 1, "ACTIVE"))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+
+	planned := testContentItem(`{"title": "Main", "links": [{"url": "/"}]}`)
+	planned.ID = types.StringUnknown()
+	planned.Version = types.Int64Unknown()
+	plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: newTestState(t, schemaResp, planned).Raw}
+
+	resp := &resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, resp)
+	require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+
+	assert.Equal(t, []string{
+		`POST /content-repositories/repository-id/content-items {"body":{"_meta":{"deliveryKey":"navigation/main",` +
+			`"name":"Main navigation","schema":"https://example.com/navigation"},"links":[{"url":"/"}],"title":"Main"},` +
+			`"label":"Main navigation","locale":"en-GB"}`,
+	}, requests)
+
+	var result ContentItem
+	require.False(t, resp.State.Get(ctx, &result).HasError())
+	assert.Equal(t, "item-id", result.ID.ValueString())
+	assert.Equal(t, int64(1), result.Version.ValueInt64())
+	assert.Equal(t, `{"title": "Main", "links": [{"url": "/"}]}`, result.Body.ValueString())
+}
+
+func TestContentItemResourceCreateInvalidBody(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	r, schemaResp := newTestResource(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/hubs/test-hub/content-type-schemas":
+			writeTestSchemas(w)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+
+	planned := testContentItem(`{"links": [{}]}`)
+	planned.ID = types.StringUnknown()
+	planned.Version = types.Int64Unknown()
+	plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: newTestState(t, schemaResp, planned).Raw}
+
+	resp := &resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, resp)
+
+	require.True(t, resp.Diagnostics.HasError())
+	assert.Equal(t, "Content item body does not match its schema", resp.Diagnostics[0].Summary())
+	assert.Contains(t, resp.Diagnostics[0].Detail(), "/: missing properties: 'title'")
+	assert.Contains(t, resp.Diagnostics[0].Detail(), "/links/0: missing properties: 'url'")
+}
+
+func TestContentItemResourceRead(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	r, schemaResp := newTestResource(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/content-items/item-id":
+			testutils.WriteJSON(w, http.StatusOK, testContentItemResponse(
+				`{"_meta": {"schema": "https://example.com/navigation", "name": "Main navigation", "deliveryKey": "nav"}, "title": "Changed"}`,
+				4, "ACTIVE"))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+
+	state := newTestState(t, schemaResp, testContentItem(`{"title": "Main"}`))
+	resp := &resource.ReadResponse{State: state}
+	r.Read(ctx, resource.ReadRequest{State: state}, resp)
+	require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+
+	var result ContentItem
+	require.False(t, resp.State.Get(ctx, &result).HasError())
+	assert.Equal(t, `{"title":"Changed"}`, result.Body.ValueString())
+	assert.Equal(t, "nav", result.DeliveryKey.ValueString())
+	assert.Equal(t, testSchemaID, result.SchemaID.ValueString())
+	assert.Equal(t, int64(4), result.Version.ValueInt64())
+}
+
+func TestContentItemResourceReadArchived(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	r, schemaResp := newTestResource(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		testutils.WriteJSON(w, http.StatusOK, testContentItemResponse(`{"title": "Main"}`, 4, "ARCHIVED"))
+	}))
+
+	state := newTestState(t, schemaResp, testContentItem(`{"title": "Main"}`))
+	resp := &resource.ReadResponse{State: state}
+	r.Read(ctx, resource.ReadRequest{State: state}, resp)
+
+	assert.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+	assert.True(t, resp.State.Raw.IsNull())
+}
+
+func TestContentItemResourceUpdateConflict(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	var version float64
+	r, schemaResp := newTestResource(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/hubs/test-hub/content-type-schemas":
+			writeTestSchemas(w)
+		case r.Method == http.MethodPatch && r.URL.Path == "/content-items/item-id":
+			var input map[string]interface{}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&input))
+			version, _ = input["version"].(float64)
+			testutils.WriteJSON(w, http.StatusConflict, map[string]interface{}{
+				"errors": []map[string]interface{}{{"message": "Version mismatch"}},
+			})
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+
+	state := newTestState(t, schemaResp, testContentItem(`{"title": "Main"}`))
+	planned := testContentItem(`{"title": "Updated"}`)
+	planned.Version = types.Int64Unknown()
+	plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: newTestState(t, schemaResp, planned).Raw}
+
+	resp := &resource.UpdateResponse{State: state}
+	r.Update(ctx, resource.UpdateRequest{State: state, Plan: plan}, resp)
+
+	require.True(t, resp.Diagnostics.HasError())
+	assert.Equal(t, float64(3), version)
+	assert.Equal(t, "Content item was changed outside of Terraform", resp.Diagnostics[0].Summary())
+}
+
+func TestContentItemResourceDelete(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	var requests []string
+	r, schemaResp := newTestResource(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		requests = append(requests, r.Method+" "+r.URL.Path+" "+string(body))
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/content-items/item-id":
+			testutils.WriteJSON(w, http.StatusOK, testContentItemResponse(`{"title": "Main"}`, 5, "ACTIVE"))
+		case r.Method == http.MethodPost && r.URL.Path == "/content-items/item-id/archive":
+			testutils.WriteJSON(w, http.StatusOK, testContentItemResponse(`{"title": "Main"}`, 6, "ARCHIVED"))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+
+	state := newTestState(t, schemaResp, testContentItem(`{"title": "Main"}`))
+	resp := &resource.DeleteResponse{State: state}
+	r.Delete(ctx, resource.DeleteRequest{State: state}, resp)
+	require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+
+	assert.Equal(t, []string{
+		"GET /content-items/item-id ",
+		`POST /content-items/item-id/archive {"version":5}`,
+	}, requests)
+}
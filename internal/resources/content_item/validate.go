@@ -0,0 +1,127 @@
+package content_item
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/santhosh-tekuri/jsonschema/v5"
+)
+
+// validateBody checks the body in the configuration without calling the Amplience API
+func validateBody(body string) error {
+	value, err := decodeObject([]byte(body))
+	if err != nil {
+		return fmt.Errorf("the body must be a JSON object: %w", err)
+	}
+	if _, ok := value[metaKey]; ok {
+		return fmt.Errorf("the body must not contain %q, it is set using the schema_id, label and delivery_key "+
+			"attributes", metaKey)
+	}
+	return nil
+}
+
+// validateAgainstSchema validates the body of a content item, including _meta, against the body of its content type
+// schema. References to other schemas, such as the Amplience content core and partials, cannot be resolved without
+// the API and are not checked.
+func validateAgainstSchema(schemaID string, schemaBody string, body []byte) ([]string, error) {
+	decoder := json.NewDecoder(strings.NewReader(schemaBody))
+	decoder.UseNumber()
+
+	var schemaDoc interface{}
+	if err := decoder.Decode(&schemaDoc); err != nil {
+		return nil, fmt.Errorf("the content type schema %s is not valid JSON: %w", schemaID, err)
+	}
+
+	raw, err := json.Marshal(withoutRemoteRefs(schemaDoc, schemaID))
+	if err != nil {
+		return nil, err
+	}
+
+	compiler := jsonschema.NewCompiler()
+	compiler.Draft = jsonschema.Draft7
+	compiler.LoadURL = func(s string) (io.ReadCloser, error) {
+		return nil, fmt.Errorf("loading %s is not supported", s)
+	}
+	if err := compiler.AddResource(schemaID, bytes.NewReader(raw)); err != nil {
+		return nil, fmt.Errorf("failed to load content type schema %s: %w", schemaID, err)
+	}
+	schema, err := compiler.Compile(schemaID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compile content type schema %s: %w", schemaID, err)
+	}
+
+	decoder = json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+
+	var document interface{}
+	if err := decoder.Decode(&document); err != nil {
+		return nil, err
+	}
+
+	err = schema.Validate(document)
+	if err == nil {
+		return nil, nil
+	}
+
+	var validationErr *jsonschema.ValidationError
+	if !errors.As(err, &validationErr) {
+		return nil, err
+	}
+
+	var messages []string
+	seen := map[string]bool{}
+	var collect func(*jsonschema.ValidationError)
+	collect = func(e *jsonschema.ValidationError) {
+		if len(e.Causes) == 0 {
+			location := e.InstanceLocation
+			if location == "" {
+				location = "/"
+			}
+			message := fmt.Sprintf("%s: %s", location, e.Message)
+			if !seen[message] {
+				seen[message] = true
+				messages = append(messages, message)
+			}
+			return
+		}
+		for _, cause := range e.Causes {
+			collect(cause)
+		}
+	}
+	collect(validationErr)
+	sort.Strings(messages)
+	return messages, nil
+}
+
+// withoutRemoteRefs replaces every subschema with a $ref to another schema by an empty schema, which accepts any
+// value. References within the schema itself are kept.
+func withoutRemoteRefs(value interface{}, schemaID string) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		if ref, ok := v["$ref"].(string); ok && !isLocalRef(ref, schemaID) {
+			return map[string]interface{}{}
+		}
+		result := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			result[key] = withoutRemoteRefs(item, schemaID)
+		}
+		return result
+	case []interface{}:
+		result := make([]interface{}, len(v))
+		for i, item := range v {
+			result[i] = withoutRemoteRefs(item, schemaID)
+		}
+		return result
+	default:
+		return value
+	}
+}
+
+func isLocalRef(ref string, schemaID string) bool {
+	return strings.HasPrefix(ref, "#") || ref == schemaID || strings.HasPrefix(ref, schemaID+"#")
+}
@@ -0,0 +1,105 @@
+package content_item
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testSchemaID = "https://example.com/navigation"
+
+const testSchemaBody = `{
+	"$schema": "http://json-schema.org/draft-07/schema#",
+	"$id": "https://example.com/navigation",
+	"title": "Navigation",
+	"allOf": [{"$ref": "http://bigcontent.io/cms/schema/v1/core#/definitions/content"}],
+	"type": "object",
+	"properties": {
+		"title": {"type": "string", "maxLength": 20},
+		"links": {"type": "array", "items": {"$ref": "#/definitions/link"}},
+		"image": {"$ref": "http://bigcontent.io/cms/schema/v1/core#/definitions/image-link"}
+	},
+	"required": ["title"],
+	"definitions": {
+		"link": {
+			"type": "object",
+			"properties": {"url": {"type": "string"}},
+			"required": ["url"]
+		}
+	}
+}`
+
+func TestValidateBody(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name  string
+		body  string
+		error string
+	}{
+		{name: "valid", body: `{"title": "Main"}`},
+		{name: "array", body: `[]`, error: "the body must be a JSON object"},
+		{name: "null", body: `null`, error: "the body must be a JSON object"},
+		{name: "meta", body: `{"_meta": {"schema": "x"}}`, error: `the body must not contain "_meta"`},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := validateBody(tc.body)
+			if tc.error == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tc.error)
+		})
+	}
+}
+
+func TestValidateAgainstSchema(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		body     string
+		messages []string
+	}{
+		{
+			name: "valid",
+			body: `{"_meta": {"schema": "https://example.com/navigation"}, "title": "Main", "links": [{"url": "/"}], "image": 1}`,
+		},
+		{
+			name:     "missing required property",
+			body:     `{"links": []}`,
+			messages: []string{"/: missing properties: 'title'"},
+		},
+		{
+			name: "invalid nested values",
+			body: `{"title": "A title that is much too long", "links": [{"url": "/"}, {}]}`,
+			messages: []string{
+				"/links/1: missing properties: 'url'",
+				"/title: length must be <= 20, but got 29",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			messages, err := validateAgainstSchema(testSchemaID, testSchemaBody, []byte(tc.body))
+			require.NoError(t, err)
+			assert.Equal(t, tc.messages, messages)
+		})
+	}
+}
+
+func TestValidateAgainstSchemaInvalidSchema(t *testing.T) {
+	t.Parallel()
+
+	_, err := validateAgainstSchema(testSchemaID, `{"type": `, []byte(`{}`))
+	assert.ErrorContains(t, err, "is not valid JSON")
+}
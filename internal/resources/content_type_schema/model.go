@@ -0,0 +1,49 @@
+package content_type_schema
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/labd/amplience-go-sdk/content"
+)
+
+type ContentTypeSchema struct {
+	ID              types.String         `tfsdk:"id"`
+	HubID           types.String         `tfsdk:"hub_id"`
+	SchemaID        types.String         `tfsdk:"schema_id"`
+	Body            jsontypes.Normalized `tfsdk:"body"`
+	ValidationLevel types.String         `tfsdk:"validation_level"`
+	Version         types.Int64          `tfsdk:"version"`
+	AutoSync        types.Bool           `tfsdk:"auto_sync"`
+	AdoptExisting   types.Bool           `tfsdk:"adopt_existing"`
+}
+
+func NewContentTypeSchemaFromNative(hubID string, s *content.ContentTypeSchema) *ContentTypeSchema {
+	return &ContentTypeSchema{
+		ID:              types.StringValue(s.ID),
+		HubID:           types.StringValue(hubID),
+		SchemaID:        types.StringValue(s.SchemaID),
+		Body:            jsontypes.NewNormalizedValue(s.Body),
+		ValidationLevel: types.StringValue(s.ValidationLevel),
+		Version:         types.Int64Value(int64(s.Version)),
+		AutoSync:        types.BoolValue(false),
+		AdoptExisting:   types.BoolValue(false),
+	}
+}
+
+// setLocalValuesFromState copies the attributes that only exist in Terraform, not in Amplience
+func (s *ContentTypeSchema) setLocalValuesFromState(state ContentTypeSchema) {
+	if !state.AutoSync.IsNull() && !state.AutoSync.IsUnknown() {
+		s.AutoSync = state.AutoSync
+	}
+	if !state.AdoptExisting.IsNull() && !state.AdoptExisting.IsUnknown() {
+		s.AdoptExisting = state.AdoptExisting
+	}
+}
+
+func (s *ContentTypeSchema) ToInput() content.ContentTypeSchemaInput {
+	return content.ContentTypeSchemaInput{
+		SchemaID:        s.SchemaID.ValueString(),
+		Body:            s.Body.ValueString(),
+		ValidationLevel: s.ValidationLevel.ValueString(),
+	}
+}
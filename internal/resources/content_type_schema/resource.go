@@ -0,0 +1,431 @@
+package content_type_schema
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/labd/amplience-go-sdk/content"
+	"github.com/labd/terraform-provider-amplience/internal/api"
+	"github.com/labd/terraform-provider-amplience/internal/config"
+	"github.com/labd/terraform-provider-amplience/internal/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &contentTypeSchemaResource{}
+	_ resource.ResourceWithConfigure      = &contentTypeSchemaResource{}
+	_ resource.ResourceWithImportState    = &contentTypeSchemaResource{}
+	_ resource.ResourceWithModifyPlan     = &contentTypeSchemaResource{}
+	_ resource.ResourceWithValidateConfig = &contentTypeSchemaResource{}
+)
+
+// NewContentTypeSchemaResource is a helper function to simplify the provider implementation.
+func NewContentTypeSchemaResource() resource.Resource {
+	return &contentTypeSchemaResource{}
+}
+
+// contentTypeSchemaResource is the resource implementation.
+type contentTypeSchemaResource struct {
+	client *content.Client
+	api    *api.Client
+	hubId  string
+}
+
+// Metadata returns the resource type name.
+func (r *contentTypeSchemaResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_content_type_schema"
+}
+
+// Schema defines the schema for the resource.
+func (r *contentTypeSchemaResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Content type schemas are JSON schemas that define a type of content to be created, " +
+			"including its structure, format and validation rules. In Dynamic Content, content type schemas match " +
+			"the format of the JSON Schema standard, with a few extensions and some keywords that are not supported.\n" +
+			"For more info see [Amplience Content Type Schema Docs](https://amplience.com/docs/integration/contenttypes.html)",
+		Version: 0,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the content type schema",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"hub_id": utils.HubIDAttribute(),
+			"body": schema.StringAttribute{
+				Description: "JSON definition of the schema. Differences in formatting, such as whitespace or the " +
+					"order of keys, are ignored. The body is validated during plan: the `$id` must match `schema_id`, " +
+					"it must be a valid draft-07 JSON schema, Amplience extensions such as `trait:*` and " +
+					"`ui:extension` must be well-formed and the keywords Amplience does not support (`contains`, " +
+					"`dependencies`, `if`, `then`, `else`, `patternProperties` and `propertyNames`) are rejected",
+				Required:   true,
+				CustomType: jsontypes.NormalizedType{},
+			},
+			"schema_id": schema.StringAttribute{
+				Description: "Unique schema ID",
+				Required:    true,
+				Validators:  []validator.String{utils.NoWhitespace()},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"validation_level": schema.StringAttribute{
+				Required: true,
+			},
+			"version": schema.Int64Attribute{
+				Description: "Version of the schema in Amplience, which increases when the body or validation level " +
+					"is updated",
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"auto_sync": schema.BoolAttribute{
+				Description: "Enable if you want content types to be automatically synced when the schema gets updated",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"adopt_existing": schema.BoolAttribute{
+				Description: "When an active content type schema with the same `schema_id` already exists in the " +
+					"hub, adopt it on create and update it to match this configuration. When disabled, creating the " +
+					"resource fails in that case, and the existing content type schema should be imported instead. An " +
+					"archived content type schema with the same `schema_id` is always unarchived and reused",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+// ValidateConfig validates the schema body during validate and plan, so a broken schema does not fail halfway
+// through an apply. The checks do not need the Amplience API.
+func (r *contentTypeSchemaResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config ContentTypeSchema
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Body.IsNull() || config.Body.IsUnknown() {
+		return
+	}
+
+	schemaID := ""
+	if !config.SchemaID.IsNull() && !config.SchemaID.IsUnknown() {
+		schemaID = config.SchemaID.ValueString()
+	}
+
+	for _, err := range validateSchemaBody(config.Body.ValueString(), schemaID) {
+		resp.Diagnostics.AddAttributeError(path.Root("body"), "Invalid content type schema", err.Error())
+	}
+}
+
+// ModifyPlan marks the version as unknown when the schema is updated in Amplience, which is only the case when the
+// normalized body or the validation level changes. Otherwise the version of the state is kept.
+func (r *contentTypeSchemaResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var state ContentTypeSchema
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	var plan ContentTypeSchema
+	diags = req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	changed := plan.Body.IsUnknown() || !plan.ValidationLevel.Equal(state.ValidationLevel)
+	if !changed {
+		bodyEqual, diags := plan.Body.StringSemanticEquals(ctx, state.Body)
+		resp.Diagnostics.Append(diags...)
+		changed = !bodyEqual
+	}
+	if changed {
+		diags = resp.Plan.SetAttribute(ctx, path.Root("version"), types.Int64Unknown())
+		resp.Diagnostics.Append(diags...)
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *contentTypeSchemaResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	data := req.ProviderData.(*config.ClientInfo)
+	r.client = data.Client
+	r.api = data.API
+	r.hubId = data.HubID
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *contentTypeSchemaResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ContentTypeSchema
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hubID, err := utils.RequireHubID(plan.HubID, r.hubId)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("hub_id"), "Missing hub ID", err.Error())
+		return
+	}
+	input := plan.ToInput()
+
+	instance, err := r.client.ContentTypeSchemaCreate(hubID, input)
+	if err != nil {
+		if !utils.IsAlreadyExists(err, "schemaId") {
+			resp.Diagnostics.AddError("Failed to create content type schema", utils.ErrorDetail(err))
+			return
+		}
+
+		existing, findErr := r.api.ContentTypeSchemaFindBySchemaID(ctx, hubID, input.SchemaID)
+		if findErr != nil {
+			// The schema ID was rejected for another reason
+			resp.Diagnostics.AddError("Failed to create content type schema", utils.ErrorDetail(err))
+			return
+		}
+
+		// An archived schema is what remains after a destroy, or after it was archived outside of Terraform and
+		// removed from the state on refresh. Reusing it lets Terraform recreate the schema.
+		if !utils.IsArchived(existing.Status) && !plan.AdoptExisting.ValueBool() {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("A content type schema %s already exists", input.SchemaID),
+				"Import the existing content type schema, or set adopt_existing to true to take it over.\n\n"+
+					utils.ErrorDetail(err),
+			)
+			return
+		}
+
+		tflog.Info(ctx, "content type schema already exists, adopting it", map[string]interface{}{
+			"schema_id": input.SchemaID,
+		})
+		instance, err = r.adopt(existing, input)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Failed to adopt existing content type schema %s", input.SchemaID),
+				utils.ErrorDetail(err),
+			)
+			return
+		}
+	}
+
+	result := NewContentTypeSchemaFromNative(hubID, &instance)
+	result.setLocalValuesFromState(plan)
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+// adopt unarchives the existing schema instance when necessary and updates it to match input
+func (r *contentTypeSchemaResource) adopt(instance content.ContentTypeSchema, input content.ContentTypeSchemaInput) (content.ContentTypeSchema, error) {
+	var err error
+	if utils.IsArchived(instance.Status) {
+		instance, err = r.client.ContentTypeSchemaUnarchive(instance.ID, instance.Version)
+		if err != nil {
+			return instance, err
+		}
+	}
+
+	return r.client.ContentTypeSchemaUpdate(instance, input)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *contentTypeSchemaResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ContentTypeSchema
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	instance, err := r.client.ContentTypeSchemaGet(state.ID.ValueString())
+	if err != nil {
+		if utils.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Failed to read content type schema", utils.ErrorDetail(err))
+		return
+	}
+
+	// Content type schemas cannot be deleted, only archived. An archived schema is what remains after a destroy, so
+	// treat it as gone. Create unarchives it when Terraform recreates it.
+	if utils.IsArchived(instance.Status) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	current := NewContentTypeSchemaFromNative(utils.HubID(state.HubID, r.hubId), &instance)
+	current.setLocalValuesFromState(state)
+
+	diags = resp.State.Set(ctx, current)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *contentTypeSchemaResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var state ContentTypeSchema
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var plan ContentTypeSchema
+	diags = req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bodyEqual, diags := plan.Body.StringSemanticEquals(ctx, state.Body)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// When the body was only reformatted, or only attributes that exist in Terraform changed, there is nothing to
+	// update in Amplience. The new formatting of the body is stored in the state.
+	if bodyEqual && plan.ValidationLevel.Equal(state.ValidationLevel) {
+		plan.Version = state.Version
+		diags = resp.State.Set(ctx, plan)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	instance, err := r.client.ContentTypeSchemaGet(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read content type schema", utils.ErrorDetail(err))
+		return
+	}
+
+	if utils.IsArchived(instance.Status) {
+		tflog.Info(ctx, "content type schema was archived, unarchiving it before applying the update")
+		instance, err = r.client.ContentTypeSchemaUnarchive(instance.ID, instance.Version)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to unarchive content type schema", utils.ErrorDetail(err))
+			return
+		}
+	}
+
+	instance, err = r.client.ContentTypeSchemaUpdate(instance, plan.ToInput())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update content type schema", utils.ErrorDetail(err))
+		return
+	}
+
+	hubID := utils.HubID(state.HubID, r.hubId)
+	result := NewContentTypeSchemaFromNative(hubID, &instance)
+	result.setLocalValuesFromState(plan)
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+
+	if plan.AutoSync.ValueBool() {
+		r.syncContentType(ctx, hubID, instance.SchemaID, resp)
+	}
+}
+
+// syncContentType syncs the content type of the schema, so it picks up the updated schema
+func (r *contentTypeSchemaResource) syncContentType(ctx context.Context, hubID string, schemaID string, resp *resource.UpdateResponse) {
+	contentType, err := r.api.ContentTypeFindByURI(ctx, hubID, schemaID)
+	if err != nil {
+		tflog.Info(ctx, "no content type found for schema, skipping sync", map[string]interface{}{
+			"schema_id": schemaID,
+		})
+		return
+	}
+
+	syncResult, err := r.client.ContentTypeSyncSchema(contentType)
+	if err != nil {
+		// When syncing could not be performed, for example when no content type exists with this schema, it is
+		// received as 'Authorization required.' On any error, we'll just inform that no syncing took place, and
+		// continue.
+		resp.Diagnostics.AddWarning(fmt.Sprintf("Could not auto-sync schema %s", schemaID), utils.ErrorDetail(err))
+		return
+	}
+
+	tflog.Info(ctx, "synced content type", map[string]interface{}{
+		"content_type_uri": syncResult.ContentTypeURI,
+	})
+}
+
+// Delete archives the schema, since the Amplience API does not support deleting content type schemas.
+func (r *contentTypeSchemaResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ContentTypeSchema
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	instance, err := r.client.ContentTypeSchemaGet(state.ID.ValueString())
+	if err != nil {
+		if utils.IsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError("Failed to read content type schema", utils.ErrorDetail(err))
+		return
+	}
+
+	if instance.Status == string(content.StatusActive) {
+		_, err = r.client.ContentTypeSchemaArchive(instance.ID, instance.Version)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to archive content type schema", utils.ErrorDetail(err))
+			return
+		}
+	}
+}
+
+// ImportState imports an active schema using either its ID or its schema_id, optionally prefixed with <hub_id>:
+func (r *contentTypeSchemaResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	prefix, id := utils.ParseID(req.ID)
+	hubID, err := utils.RequireHubID(types.StringValue(prefix), r.hubId)
+	if err != nil {
+		resp.Diagnostics.AddError("Missing hub ID", err.Error())
+		return
+	}
+
+	id, err = utils.ResolveImportID(id,
+		func(id string) error {
+			// An archived schema would be removed from the state on the next refresh
+			instance, err := r.client.ContentTypeSchemaGet(id)
+			if err == nil && utils.IsArchived(instance.Status) {
+				return fmt.Errorf("content type schema %s is archived. Unarchive it in Amplience before importing it", id)
+			}
+			return err
+		},
+		func(schemaID string) (string, error) {
+			match, err := r.api.ContentTypeSchemaFindActiveBySchemaID(ctx, hubID, schemaID)
+			return match.ID, err
+		},
+	)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to import content type schema", utils.ErrorDetail(err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("hub_id"), types.StringValue(hubID))...)
+}
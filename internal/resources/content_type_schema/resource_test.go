@@ -0,0 +1,382 @@
+package content_type_schema
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/labd/terraform-provider-amplience/internal/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testSchemaID = "https://schema.example.com/banner.json"
+
+func newTestResource(t *testing.T, handler http.Handler) (*contentTypeSchemaResource, resource.SchemaResponse) {
+	ctx := context.Background()
+	ci := testutils.NewClientInfo(t, handler)
+
+	r := &contentTypeSchemaResource{}
+	r.Configure(ctx, resource.ConfigureRequest{ProviderData: ci}, &resource.ConfigureResponse{})
+
+	schemaResp := resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	return r, schemaResp
+}
+
+func newTestState(t *testing.T, schemaResp resource.SchemaResponse, value *ContentTypeSchema) tfsdk.State {
+	ctx := context.Background()
+	state := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	diags := state.Set(ctx, value)
+	require.False(t, diags.HasError(), "%v", diags)
+	return state
+}
+
+func testContentTypeSchema(body string) *ContentTypeSchema {
+	return &ContentTypeSchema{
+		ID:              types.StringValue("5f7d5f7d5f7d5f7d5f7d5f7d"),
+		HubID:           types.StringValue(testutils.HubID),
+		SchemaID:        types.StringValue(testSchemaID),
+		Body:            jsontypes.NewNormalizedValue(body),
+		ValidationLevel: types.StringValue("CONTENT_TYPE"),
+		Version:         types.Int64Value(3),
+		AutoSync:        types.BoolValue(false),
+		AdoptExisting:   types.BoolValue(false),
+	}
+}
+
+func TestContentTypeSchemaResourceReadRemoved(t *testing.T) {
+	t.Parallel()
+	tcs := []struct {
+		Name    string
+		Handler http.HandlerFunc
+	}{
+		{
+			Name:    "Not found",
+			Handler: testutils.NotFound,
+		},
+		{
+			Name: "Archived",
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				testutils.WriteJSON(w, http.StatusOK, map[string]interface{}{
+					"id":       "5f7d5f7d5f7d5f7d5f7d5f7d",
+					"schemaId": testSchemaID,
+					"body":     `{}`,
+					"status":   "ARCHIVED",
+				})
+			},
+		},
+	}
+
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+			r, schemaResp := newTestResource(t, tc.Handler)
+			state := newTestState(t, schemaResp, testContentTypeSchema(`{}`))
+
+			resp := &resource.ReadResponse{State: state}
+			r.Read(ctx, resource.ReadRequest{State: state}, resp)
+
+			assert.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+			assert.True(t, resp.State.Raw.IsNull())
+		})
+	}
+}
+
+func TestContentTypeSchemaResourceUpdateReformattedBody(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	r, schemaResp := newTestResource(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+	}))
+
+	state := newTestState(t, schemaResp, testContentTypeSchema(`{"$id":"`+testSchemaID+`","title":"Banner"}`))
+
+	planned := testContentTypeSchema("{\n  \"title\": \"Banner\",\n  \"$id\": \"" + testSchemaID + "\"\n}")
+	planned.Version = types.Int64Unknown()
+	planned.AutoSync = types.BoolValue(true)
+	plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: newTestState(t, schemaResp, planned).Raw}
+
+	resp := &resource.UpdateResponse{State: state}
+	r.Update(ctx, resource.UpdateRequest{State: state, Plan: plan}, resp)
+	require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+
+	var result ContentTypeSchema
+	require.False(t, resp.State.Get(ctx, &result).HasError())
+	assert.Equal(t, planned.Body.ValueString(), result.Body.ValueString())
+	assert.Equal(t, int64(3), result.Version.ValueInt64())
+	assert.True(t, result.AutoSync.ValueBool())
+}
+
+func TestContentTypeSchemaResourceModifyPlanVersion(t *testing.T) {
+	t.Parallel()
+	body := `{"$id":"` + testSchemaID + `","title":"Banner"}`
+
+	tcs := []struct {
+		Name            string
+		Body            string
+		ValidationLevel string
+		Unknown         bool
+	}{
+		{
+			Name:            "Unchanged",
+			Body:            body,
+			ValidationLevel: "CONTENT_TYPE",
+		},
+		{
+			Name:            "Reformatted body",
+			Body:            "{\n  \"title\": \"Banner\",\n  \"$id\": \"" + testSchemaID + "\"\n}",
+			ValidationLevel: "CONTENT_TYPE",
+		},
+		{
+			Name:            "Changed body",
+			Body:            `{"$id":"` + testSchemaID + `","title":"Card"}`,
+			ValidationLevel: "CONTENT_TYPE",
+			Unknown:         true,
+		},
+		{
+			Name:            "Changed validation level",
+			Body:            body,
+			ValidationLevel: "SLOT",
+			Unknown:         true,
+		},
+	}
+
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+			r, schemaResp := newTestResource(t, http.HandlerFunc(testutils.NotFound))
+
+			state := newTestState(t, schemaResp, testContentTypeSchema(body))
+			planned := testContentTypeSchema(tc.Body)
+			planned.ValidationLevel = types.StringValue(tc.ValidationLevel)
+			plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: newTestState(t, schemaResp, planned).Raw}
+
+			resp := &resource.ModifyPlanResponse{Plan: plan}
+			r.ModifyPlan(ctx, resource.ModifyPlanRequest{State: state, Plan: plan}, resp)
+			require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+
+			var result ContentTypeSchema
+			require.False(t, resp.Plan.Get(ctx, &result).HasError())
+			if tc.Unknown {
+				assert.True(t, result.Version.IsUnknown())
+			} else {
+				assert.Equal(t, int64(3), result.Version.ValueInt64())
+			}
+		})
+	}
+}
+
+func TestContentTypeSchemaResourceImportState(t *testing.T) {
+	t.Parallel()
+	tcs := []struct {
+		Name     string
+		ID       string
+		HubID    string
+		Expected string
+		Error    string
+	}{
+		{
+			Name:     "Uses the hub of the provider",
+			ID:       "5f7d5f7d5f7d5f7d5f7d5f7d",
+			HubID:    testutils.HubID,
+			Expected: "5f7d5f7d5f7d5f7d5f7d5f7d",
+		},
+		{
+			Name:     "Uses the hub of the import ID",
+			ID:       "other-hub:5f7d5f7d5f7d5f7d5f7d5f7d",
+			HubID:    "other-hub",
+			Expected: "5f7d5f7d5f7d5f7d5f7d5f7d",
+		},
+		{
+			Name:  "Fails for an archived schema ID",
+			ID:    "6a8e6a8e6a8e6a8e6a8e6a8e",
+			HubID: testutils.HubID,
+			Error: "content type schema 6a8e6a8e6a8e6a8e6a8e6a8e is archived",
+		},
+		{
+			Name:     "Finds the schema by schema_id",
+			ID:       testSchemaID,
+			HubID:    testutils.HubID,
+			Expected: "5f7d5f7d5f7d5f7d5f7d5f7d",
+		},
+		{
+			Name:     "Finds the schema by schema_id in the hub of the import ID",
+			ID:       "other-hub:" + testSchemaID,
+			HubID:    "other-hub",
+			Expected: "5f7d5f7d5f7d5f7d5f7d5f7d",
+		},
+		{
+			Name:     "Finds the active schema when an archived schema has the same schema_id",
+			ID:       "https://schema.example.com/card.json",
+			HubID:    testutils.HubID,
+			Expected: "7b9f7b9f7b9f7b9f7b9f7b9f",
+		},
+		{
+			Name:     "Resolves a schema_id that looks like an ID",
+			ID:       "abcdefabcdefabcdefabcdef",
+			HubID:    testutils.HubID,
+			Expected: "8c0a8c0a8c0a8c0a8c0a8c0a",
+		},
+		{
+			Name:  "Fails for an archived schema_id",
+			ID:    "https://schema.example.com/old.json",
+			HubID: testutils.HubID,
+			Error: `the content type schema with schema_id "https://schema.example.com/old.json" is archived`,
+		},
+		{
+			Name:  "Fails for an unknown schema_id",
+			ID:    "https://schema.example.com/missing.json",
+			HubID: testutils.HubID,
+			Error: `no content type schema found with schema_id "https://schema.example.com/missing.json"`,
+		},
+	}
+
+	schemas := []map[string]interface{}{
+		{"id": "5f7d5f7d5f7d5f7d5f7d5f7d", "schemaId": testSchemaID, "status": "ACTIVE"},
+		{"id": "6a8e6a8e6a8e6a8e6a8e6a8e", "schemaId": "https://schema.example.com/card.json", "status": "ARCHIVED"},
+		{"id": "7b9f7b9f7b9f7b9f7b9f7b9f", "schemaId": "https://schema.example.com/card.json", "status": "ACTIVE"},
+		{"id": "8c0a8c0a8c0a8c0a8c0a8c0a", "schemaId": "abcdefabcdefabcdefabcdef", "status": "ACTIVE"},
+		{"id": "9d1b9d1b9d1b9d1b9d1b9d1b", "schemaId": "https://schema.example.com/old.json", "status": "ARCHIVED"},
+	}
+
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+			r, schemaResp := newTestResource(t, http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				if req.URL.Path == "/hubs/"+tc.HubID+"/content-type-schemas" {
+					testutils.WriteJSON(w, http.StatusOK, map[string]interface{}{
+						"_embedded": map[string]interface{}{"content-type-schemas": schemas},
+						"page":      map[string]interface{}{"totalPages": 1},
+					})
+					return
+				}
+				for _, schema := range schemas {
+					if req.URL.Path == "/content-type-schemas/"+schema["id"].(string) {
+						testutils.WriteJSON(w, http.StatusOK, schema)
+						return
+					}
+				}
+				testutils.NotFound(w, req)
+			}))
+
+			resp := &resource.ImportStateResponse{
+				State: tfsdk.State{
+					Schema: schemaResp.Schema,
+					Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+				},
+			}
+			r.ImportState(ctx, resource.ImportStateRequest{ID: tc.ID}, resp)
+			if tc.Error != "" {
+				require.True(t, resp.Diagnostics.HasError())
+				assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), tc.Error)
+				return
+			}
+			require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+
+			var result ContentTypeSchema
+			require.False(t, resp.State.Get(ctx, &result).HasError())
+			assert.Equal(t, tc.Expected, result.ID.ValueString())
+			assert.Equal(t, tc.HubID, result.HubID.ValueString())
+		})
+	}
+}
+
+func TestContentTypeSchemaResourceValidateConfig(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	r, schemaResp := newTestResource(t, http.NotFoundHandler())
+
+	value := testContentTypeSchema(`{"$id": "https://schema.example.com/other.json"}`)
+	config := tfsdk.Config{Schema: schemaResp.Schema, Raw: newTestState(t, schemaResp, value).Raw}
+
+	resp := &resource.ValidateConfigResponse{}
+	r.ValidateConfig(ctx, resource.ValidateConfigRequest{Config: config}, resp)
+
+	require.Len(t, resp.Diagnostics, 1)
+	assert.Equal(t, "Invalid content type schema", resp.Diagnostics[0].Summary())
+	assert.Contains(t, resp.Diagnostics[0].Detail(), `$["$id"]: the $id "https://schema.example.com/other.json" does not match`)
+}
+
+func TestContentTypeSchemaResourceCreateUnarchivesExisting(t *testing.T) {
+	t.Parallel()
+	existing := map[string]interface{}{
+		"id":              "5f7d5f7d5f7d5f7d5f7d5f7d",
+		"schemaId":        testSchemaID,
+		"body":            `{"type": "object"}`,
+		"validationLevel": "CONTENT_TYPE",
+		"status":          "ARCHIVED",
+		"version":         3,
+	}
+
+	var requests []string
+	r, schemaResp := newTestResource(t, http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		requests = append(requests, req.Method+" "+req.URL.Path)
+
+		switch {
+		case req.Method == http.MethodPost && req.URL.Path == "/hubs/test-hub/content-type-schemas":
+			testutils.WriteJSON(w, http.StatusBadRequest, map[string]interface{}{
+				"errors": []map[string]interface{}{
+					{
+						"level":        "ERROR",
+						"code":         "SCHEMA_ID_ALREADY_REGISTERED",
+						"entity":       "ContentTypeSchema",
+						"property":     "schemaId",
+						"invalidValue": testSchemaID,
+						"message":      "Schema already registered",
+					},
+				},
+			})
+		case req.Method == http.MethodGet && req.URL.Path == "/hubs/test-hub/content-type-schemas":
+			testutils.WriteJSON(w, http.StatusOK, map[string]interface{}{
+				"_embedded": map[string]interface{}{"content-type-schemas": []interface{}{existing}},
+				"page":      map[string]interface{}{"totalPages": 1},
+			})
+		case req.Method == http.MethodPost && req.URL.Path == "/content-type-schemas/5f7d5f7d5f7d5f7d5f7d5f7d/unarchive":
+			existing["status"] = "ACTIVE"
+			testutils.WriteJSON(w, http.StatusOK, existing)
+		case req.Method == http.MethodPatch && req.URL.Path == "/content-type-schemas/5f7d5f7d5f7d5f7d5f7d5f7d":
+			existing["body"] = `{"type": "object", "title": "Banner"}`
+			existing["version"] = 4
+			testutils.WriteJSON(w, http.StatusOK, existing)
+		default:
+			t.Errorf("unexpected request %s %s", req.Method, req.URL.Path)
+		}
+	}))
+
+	plan := testContentTypeSchema(`{"type": "object", "title": "Banner"}`)
+	plan.ID = types.StringUnknown()
+	plan.Version = types.Int64Unknown()
+
+	resp := resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	r.Create(context.Background(), resource.CreateRequest{
+		Plan: tfsdk.Plan{Schema: schemaResp.Schema, Raw: newTestState(t, schemaResp, plan).Raw},
+	}, &resp)
+	require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+
+	assert.Equal(t, []string{
+		"POST /hubs/test-hub/content-type-schemas",
+		"GET /hubs/test-hub/content-type-schemas",
+		"POST /content-type-schemas/5f7d5f7d5f7d5f7d5f7d5f7d/unarchive",
+		"PATCH /content-type-schemas/5f7d5f7d5f7d5f7d5f7d5f7d",
+	}, requests)
+
+	var result ContentTypeSchema
+	require.False(t, resp.State.Get(context.Background(), &result).HasError())
+	assert.Equal(t, "5f7d5f7d5f7d5f7d5f7d5f7d", result.ID.ValueString())
+	assert.Equal(t, int64(4), result.Version.ValueInt64())
+}
@@ -0,0 +1,354 @@
+package content_type_schema
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/santhosh-tekuri/jsonschema/v5"
+)
+
+const (
+	draft07SchemaURL = "http://json-schema.org/draft-07/schema#"
+
+	// contentCoreRef is the Amplience definition that content type and slot schemas extend, which adds the _meta
+	// property to the content
+	contentCoreRef = "http://bigcontent.io/cms/schema/v1/core#/definitions/content"
+)
+
+// unsupportedKeywords are the JSON Schema draft-07 keywords that Amplience does not support in content type schemas
+var unsupportedKeywords = map[string]bool{
+	"contains":          true,
+	"dependencies":      true,
+	"else":              true,
+	"if":                true,
+	"patternProperties": true,
+	"propertyNames":     true,
+	"then":              true,
+}
+
+// traitProperties are the properties that must be set on the known Amplience traits, all of which are arrays
+var traitProperties = map[string]string{
+	"trait:filterable": "filterBy",
+	"trait:hierarchy":  "childContentTypes",
+	"trait:sortable":   "sortBy",
+}
+
+var draft07 = jsonschema.MustCompile(draft07SchemaURL)
+
+// schemaError is a problem with a content type schema body at the JSON path Path
+type schemaError struct {
+	Path    string
+	Message string
+}
+
+func (e schemaError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Path, e.Message)
+}
+
+// validateSchemaBody checks a content type schema body without calling the Amplience API. It checks that the body is
+// valid JSON, that its $id matches schemaID (unless schemaID is empty), that it is a valid draft-07 JSON schema, that
+// the Amplience extension keywords are used correctly and that it does not use keywords Amplience does not support.
+func validateSchemaBody(body string, schemaID string) []schemaError {
+	decoder := json.NewDecoder(strings.NewReader(body))
+	decoder.UseNumber()
+
+	var document interface{}
+	if err := decoder.Decode(&document); err != nil {
+		return []schemaError{{Path: "$", Message: describeSyntaxError(body, err)}}
+	}
+	if decoder.More() {
+		return []schemaError{{Path: "$", Message: "unexpected data after the top-level JSON value"}}
+	}
+
+	root, ok := document.(map[string]interface{})
+	if !ok {
+		return []schemaError{{Path: "$", Message: "the schema must be a JSON object"}}
+	}
+
+	var errs []schemaError
+	errs = append(errs, validateRoot(root, schemaID)...)
+	errs = append(errs, validateDraft07(document)...)
+
+	v := &schemaWalker{}
+	v.walk(jsonPath{}, root)
+	errs = append(errs, v.errs...)
+
+	sort.SliceStable(errs, func(i, j int) bool { return errs[i].Path < errs[j].Path })
+	return errs
+}
+
+func validateRoot(root map[string]interface{}, schemaID string) []schemaError {
+	var errs []schemaError
+
+	id, ok := root["$id"].(string)
+	switch {
+	case !ok:
+		errs = append(errs, schemaError{Path: "$", Message: "the schema must have an $id"})
+	case schemaID != "" && id != schemaID:
+		errs = append(errs, schemaError{
+			Path:    jsonPath{"$id"}.String(),
+			Message: fmt.Sprintf("the $id %q does not match the schema_id %q", id, schemaID),
+		})
+	}
+
+	if value, ok := root["$schema"]; ok && value != draft07SchemaURL && value != strings.TrimSuffix(draft07SchemaURL, "#") {
+		errs = append(errs, schemaError{
+			Path:    jsonPath{"$schema"}.String(),
+			Message: fmt.Sprintf("Amplience only supports JSON Schema draft-07, the $schema should be %q", draft07SchemaURL),
+		})
+	}
+
+	// Partials only contain definitions; content type and slot schemas, which have properties, must extend the
+	// content core
+	if _, ok := root["properties"]; ok && !hasContentCore(root) {
+		errs = append(errs, schemaError{
+			Path:    jsonPath{"allOf"}.String(),
+			Message: fmt.Sprintf("the schema must extend the content core using \"allOf\": [{\"$ref\": %q}]", contentCoreRef),
+		})
+	}
+
+	return errs
+}
+
+func hasContentCore(root map[string]interface{}) bool {
+	allOf, _ := root["allOf"].([]interface{})
+	for _, item := range allOf {
+		if ref, ok := item.(map[string]interface{}); ok && ref["$ref"] == contentCoreRef {
+			return true
+		}
+	}
+	return false
+}
+
+// validateDraft07 validates the document against the draft-07 meta-schema, reporting one error per location
+func validateDraft07(document interface{}) []schemaError {
+	err := draft07.Validate(document)
+	if err == nil {
+		return nil
+	}
+
+	var validationErr *jsonschema.ValidationError
+	if !errors.As(err, &validationErr) {
+		return []schemaError{{Path: "$", Message: err.Error()}}
+	}
+
+	var errs []schemaError
+	seen := map[string]bool{}
+	var collect func(*jsonschema.ValidationError)
+	collect = func(e *jsonschema.ValidationError) {
+		if len(e.Causes) == 0 {
+			if !seen[e.InstanceLocation] {
+				seen[e.InstanceLocation] = true
+				errs = append(errs, schemaError{Path: pointerToPath(e.InstanceLocation), Message: e.Message})
+			}
+			return
+		}
+		for _, cause := range e.Causes {
+			collect(cause)
+		}
+	}
+	collect(validationErr)
+	return errs
+}
+
+// schemaWalker visits every (sub)schema of a content type schema to check the Amplience specific rules
+type schemaWalker struct {
+	errs []schemaError
+}
+
+func (v *schemaWalker) fail(p jsonPath, format string, args ...interface{}) {
+	v.errs = append(v.errs, schemaError{Path: p.String(), Message: fmt.Sprintf(format, args...)})
+}
+
+func (v *schemaWalker) walk(p jsonPath, value interface{}) {
+	schema, ok := value.(map[string]interface{})
+	if !ok {
+		return
+	}
+
+	for _, keyword := range sortedKeys(schema) {
+		child := schema[keyword]
+		childPath := p.key(keyword)
+
+		switch {
+		case unsupportedKeywords[keyword]:
+			v.fail(childPath, "the %q keyword is not supported by Amplience", keyword)
+			continue
+		case keyword == "ui:extension":
+			v.validateExtension(childPath, child)
+			continue
+		case strings.HasPrefix(keyword, "trait:"):
+			v.validateTrait(childPath, keyword, child)
+			continue
+		}
+
+		switch keyword {
+		case "properties", "definitions":
+			if properties, ok := child.(map[string]interface{}); ok {
+				for _, name := range sortedKeys(properties) {
+					v.walk(childPath.key(name), properties[name])
+				}
+			}
+		case "allOf", "anyOf", "oneOf":
+			if items, ok := child.([]interface{}); ok {
+				for i, item := range items {
+					v.walk(childPath.index(i), item)
+				}
+			}
+		case "items":
+			if items, ok := child.([]interface{}); ok {
+				for i, item := range items {
+					v.walk(childPath.index(i), item)
+				}
+			} else {
+				v.walk(childPath, child)
+			}
+		case "additionalItems", "additionalProperties", "not":
+			v.walk(childPath, child)
+		}
+	}
+}
+
+// validateExtension checks a ui:extension, which refers to either a registered extension by name or to the URL of
+// an extension
+func (v *schemaWalker) validateExtension(p jsonPath, value interface{}) {
+	extension, ok := value.(map[string]interface{})
+	if !ok {
+		v.fail(p, "ui:extension must be an object")
+		return
+	}
+
+	name, hasName := extension["name"]
+	rawURL, hasURL := extension["url"]
+	switch {
+	case hasName && hasURL:
+		v.fail(p, "ui:extension must have either a name or a url, not both")
+	case hasName:
+		if s, ok := name.(string); !ok || s == "" {
+			v.fail(p.key("name"), "the name of an extension must be a non-empty string")
+		}
+	case hasURL:
+		s, _ := rawURL.(string)
+		if u, err := url.Parse(s); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			v.fail(p.key("url"), "the url of an extension must be an http(s) URL")
+		}
+	default:
+		v.fail(p, "ui:extension must have a name or a url")
+	}
+
+	if params, ok := extension["params"]; ok {
+		if _, ok := params.(map[string]interface{}); !ok {
+			v.fail(p.key("params"), "the params of an extension must be an object")
+		}
+	}
+	if height, ok := extension["height"]; ok {
+		if _, ok := height.(json.Number); !ok {
+			v.fail(p.key("height"), "the height of an extension must be a number")
+		}
+	}
+}
+
+// validateTrait checks a trait:* keyword. Unknown traits are only checked to be objects, so new Amplience traits can
+// be used without an update of the provider.
+func (v *schemaWalker) validateTrait(p jsonPath, keyword string, value interface{}) {
+	trait, ok := value.(map[string]interface{})
+	if !ok {
+		v.fail(p, "%s must be an object", keyword)
+		return
+	}
+
+	property, ok := traitProperties[keyword]
+	if !ok {
+		return
+	}
+	if _, ok := trait[property].([]interface{}); !ok {
+		v.fail(p.key(property), "%s must have a %q array", keyword, property)
+	}
+}
+
+// describeSyntaxError adds the line and column to JSON syntax errors
+func describeSyntaxError(body string, err error) string {
+	var syntaxErr *json.SyntaxError
+	var typeErr *json.UnmarshalTypeError
+	var offset int64
+	switch {
+	case errors.As(err, &syntaxErr):
+		offset = syntaxErr.Offset
+	case errors.As(err, &typeErr):
+		offset = typeErr.Offset
+	default:
+		return fmt.Sprintf("invalid JSON: %s", err)
+	}
+
+	// The offset is just after the character that caused the error
+	before := []byte(body)[:min(max(int(offset)-1, 0), len(body))]
+	line := bytes.Count(before, []byte("\n")) + 1
+	column := len(before) - bytes.LastIndexByte(before, '\n')
+	return fmt.Sprintf("invalid JSON at line %d, column %d: %s", line, column, err)
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// jsonPath is the location of a value in a JSON document, consisting of object keys (strings) and array indexes
+// (ints)
+type jsonPath []interface{}
+
+var identifier = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+func (p jsonPath) key(key string) jsonPath {
+	return append(p[:len(p):len(p)], key)
+}
+
+func (p jsonPath) index(index int) jsonPath {
+	return append(p[:len(p):len(p)], index)
+}
+
+func (p jsonPath) String() string {
+	var b strings.Builder
+	b.WriteString("$")
+	for _, item := range p {
+		switch value := item.(type) {
+		case int:
+			fmt.Fprintf(&b, "[%d]", value)
+		case string:
+			if identifier.MatchString(value) {
+				b.WriteString("." + value)
+			} else {
+				fmt.Fprintf(&b, "[%s]", strconv.Quote(value))
+			}
+		}
+	}
+	return b.String()
+}
+
+// pointerToPath converts a JSON pointer to a JSON path. Numeric tokens are assumed to be array indexes.
+func pointerToPath(pointer string) string {
+	p := jsonPath{}
+	if pointer == "" {
+		return p.String()
+	}
+
+	for _, token := range strings.Split(strings.TrimPrefix(pointer, "/"), "/") {
+		token = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+		if index, err := strconv.Atoi(token); err == nil {
+			p = p.index(index)
+		} else {
+			p = p.key(token)
+		}
+	}
+	return p.String()
+}
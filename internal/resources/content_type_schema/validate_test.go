@@ -0,0 +1,172 @@
+package content_type_schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const validSchemaBody = `{
+  "$id": "https://schema.example.com/banner.json",
+  "$schema": "http://json-schema.org/draft-07/schema#",
+  "allOf": [
+    {
+      "$ref": "http://bigcontent.io/cms/schema/v1/core#/definitions/content"
+    }
+  ],
+  "title": "Banner",
+  "type": "object",
+  "trait:sortable": {
+    "sortBy": [{"key": "default", "paths": ["/title"]}]
+  },
+  "properties": {
+    "title": {
+      "type": "string",
+      "minLength": 1
+    },
+    "if": {
+      "type": "string",
+      "ui:extension": {
+        "name": "color-picker",
+        "params": {"palette": "brand"}
+      }
+    },
+    "tags": {
+      "type": "array",
+      "items": {
+        "type": "string"
+      }
+    }
+  },
+  "propertyOrder": ["title", "if", "tags"]
+}`
+
+func TestValidateSchemaBody(t *testing.T) {
+	t.Parallel()
+	tcs := []struct {
+		Name     string
+		Body     string
+		SchemaID string
+		Errors   []string
+	}{
+		{
+			Name:     "Valid schema",
+			Body:     validSchemaBody,
+			SchemaID: "https://schema.example.com/banner.json",
+		},
+		{
+			Name: "Valid partial",
+			Body: `{
+				"$id": "https://schema.example.com/partials.json",
+				"$schema": "http://json-schema.org/draft-07/schema#",
+				"definitions": {"link": {"type": "string", "format": "uri"}}
+			}`,
+			SchemaID: "https://schema.example.com/partials.json",
+		},
+		{
+			Name:     "Invalid JSON",
+			Body:     "{\n  \"$id\": \"https://schema.example.com/banner.json\",\n  \"title\" \"Banner\"\n}",
+			SchemaID: "https://schema.example.com/banner.json",
+			Errors:   []string{"$: invalid JSON at line 3, column 11: invalid character '\"' after object key"},
+		},
+		{
+			Name:   "Not an object",
+			Body:   `["https://schema.example.com/banner.json"]`,
+			Errors: []string{"$: the schema must be a JSON object"},
+		},
+		{
+			Name:     "Mismatching $id",
+			Body:     `{"$id": "https://schema.example.com/other.json", "type": "object"}`,
+			SchemaID: "https://schema.example.com/banner.json",
+			Errors: []string{
+				`$["$id"]: the $id "https://schema.example.com/other.json" does not match the schema_id "https://schema.example.com/banner.json"`,
+			},
+		},
+		{
+			Name:   "Missing $id",
+			Body:   `{"type": "object"}`,
+			Errors: []string{"$: the schema must have an $id"},
+		},
+		{
+			Name:   "Other draft",
+			Body:   `{"$id": "https://schema.example.com/banner.json", "$schema": "https://json-schema.org/draft/2020-12/schema"}`,
+			Errors: []string{`$["$schema"]: Amplience only supports JSON Schema draft-07, the $schema should be "http://json-schema.org/draft-07/schema#"`},
+		},
+		{
+			Name: "Missing content core",
+			Body: `{"$id": "https://schema.example.com/banner.json", "properties": {"title": {"type": "string"}}}`,
+			Errors: []string{
+				`$.allOf: the schema must extend the content core using "allOf": [{"$ref": "http://bigcontent.io/cms/schema/v1/core#/definitions/content"}]`,
+			},
+		},
+		{
+			Name: "Invalid draft-07 schema",
+			Body: `{
+				"$id": "https://schema.example.com/banner.json",
+				"allOf": [{"$ref": "http://bigcontent.io/cms/schema/v1/core#/definitions/content"}],
+				"type": "objct",
+				"properties": {"title": {"type": "string", "minLength": -1}},
+				"required": "title"
+			}`,
+			Errors: []string{
+				"$.properties.title.minLength: must be >= 0 but found -1",
+				"$.required: expected array, but got string",
+				`$.type: value must be one of "array", "boolean", "integer", "null", "number", "object", "string"`,
+			},
+		},
+		{
+			Name: "Unsupported keywords",
+			Body: `{
+				"$id": "https://schema.example.com/banner.json",
+				"allOf": [{"$ref": "http://bigcontent.io/cms/schema/v1/core#/definitions/content"}],
+				"properties": {
+					"tags": {"type": "array", "items": {"type": "object", "patternProperties": {"^x-": {}}}},
+					"size": {"type": "string", "if": {"const": "large"}, "then": {"maxLength": 10}}
+				}
+			}`,
+			Errors: []string{
+				`$.properties.size.if: the "if" keyword is not supported by Amplience`,
+				`$.properties.size.then: the "then" keyword is not supported by Amplience`,
+				`$.properties.tags.items.patternProperties: the "patternProperties" keyword is not supported by Amplience`,
+			},
+		},
+		{
+			Name: "Invalid extensions and traits",
+			Body: `{
+				"$id": "https://schema.example.com/banner.json",
+				"allOf": [{"$ref": "http://bigcontent.io/cms/schema/v1/core#/definitions/content"}],
+				"trait:hierarchy": {"childContentTypes": "https://schema.example.com/banner.json"},
+				"trait:custom": true,
+				"properties": {
+					"color": {"type": "string", "ui:extension": {"url": "ftp://extensions.example.com", "height": "200px"}},
+					"image": {"type": "string", "ui:extension": {"params": {}}}
+				}
+			}`,
+			Errors: []string{
+				`$.properties.color["ui:extension"].height: the height of an extension must be a number`,
+				`$.properties.color["ui:extension"].url: the url of an extension must be an http(s) URL`,
+				`$.properties.image["ui:extension"]: ui:extension must have a name or a url`,
+				`$["trait:custom"]: trait:custom must be an object`,
+				`$["trait:hierarchy"].childContentTypes: trait:hierarchy must have a "childContentTypes" array`,
+			},
+		},
+	}
+
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			var errs []string
+			for _, err := range validateSchemaBody(tc.Body, tc.SchemaID) {
+				errs = append(errs, err.Error())
+			}
+			assert.Equal(t, tc.Errors, errs)
+		})
+	}
+}
+
+func TestPointerToPath(t *testing.T) {
+	assert.Equal(t, "$", pointerToPath(""))
+	assert.Equal(t, "$.properties.title.allOf[0]", pointerToPath("/properties/title/allOf/0"))
+	assert.Equal(t, `$.properties["a/b"]["ui:extension"]`, pointerToPath("/properties/a~1b/ui:extension"))
+}
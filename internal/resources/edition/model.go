@@ -0,0 +1,55 @@
+package edition
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/labd/terraform-provider-amplience/internal/api"
+)
+
+type Edition struct {
+	ID               types.String      `tfsdk:"id"`
+	EventID          types.String      `tfsdk:"event_id"`
+	Name             types.String      `tfsdk:"name"`
+	Start            timetypes.RFC3339 `tfsdk:"start"`
+	End              timetypes.RFC3339 `tfsdk:"end"`
+	ActiveEndDate    types.Bool        `tfsdk:"active_end_date"`
+	PublishingStatus types.String      `tfsdk:"publishing_status"`
+}
+
+func NewEditionFromNative(eventID string, edition *api.Edition) (*Edition, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if edition.EventID != "" {
+		eventID = edition.EventID
+	}
+
+	result := &Edition{
+		ID:               types.StringValue(edition.ID),
+		EventID:          types.StringValue(eventID),
+		Name:             types.StringValue(edition.Name),
+		ActiveEndDate:    types.BoolValue(edition.ActiveEndDate),
+		PublishingStatus: types.StringValue(edition.PublishingStatus),
+	}
+
+	var d diag.Diagnostics
+	result.Start, d = timetypes.NewRFC3339Value(edition.Start)
+	diags.Append(d...)
+	result.End, d = timetypes.NewRFC3339Value(edition.End)
+	diags.Append(d...)
+	return result, diags
+}
+
+func (e *Edition) ToInput() api.Edition {
+	return api.Edition{
+		Name:          e.Name.ValueString(),
+		Start:         e.Start.ValueString(),
+		End:           e.End.ValueString(),
+		ActiveEndDate: e.ActiveEndDate.ValueBool(),
+	}
+}
+
+// isLocked returns whether the edition was scheduled or published when it was last read
+func (e *Edition) isLocked() bool {
+	edition := api.Edition{PublishingStatus: e.PublishingStatus.ValueString()}
+	return edition.IsLocked()
+}
@@ -0,0 +1,417 @@
+package edition
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/labd/terraform-provider-amplience/internal/api"
+	"github.com/labd/terraform-provider-amplience/internal/config"
+	"github.com/labd/terraform-provider-amplience/internal/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &editionResource{}
+	_ resource.ResourceWithConfigure      = &editionResource{}
+	_ resource.ResourceWithImportState    = &editionResource{}
+	_ resource.ResourceWithModifyPlan     = &editionResource{}
+	_ resource.ResourceWithValidateConfig = &editionResource{}
+)
+
+// NewEditionResource is a helper function to simplify the provider implementation.
+func NewEditionResource() resource.Resource {
+	return &editionResource{}
+}
+
+// editionResource is the resource implementation.
+type editionResource struct {
+	api *api.Client
+}
+
+// Metadata returns the resource type name.
+func (r *editionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_edition"
+}
+
+// Schema defines the schema for the resource.
+func (r *editionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "An edition of an event, which contains the content that is published during (a part " +
+			"of) the event. The edition must start and end within the event.\n\n" +
+			"Once an edition is scheduled or published in Amplience it can no longer be changed by the provider: " +
+			"planning a change to its configuration fails, changes made in Amplience only result in a warning, and " +
+			"destroying the resource only removes it from the Terraform state.\n" +
+			"For more info see [Amplience Events Docs](https://amplience.com/developers/docs/user-guides/schedule-content/events-and-editions/)",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the edition",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"event_id": schema.StringAttribute{
+				Description: "ID of the event of the edition",
+				Required:    true,
+				Validators:  []validator.String{utils.NoWhitespace()},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the edition",
+				Required:    true,
+				Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"start": schema.StringAttribute{
+				Description: "Start of the edition as an RFC3339 timestamp, which must not be before the start of " +
+					"the event",
+				Required:   true,
+				CustomType: timetypes.RFC3339Type{},
+			},
+			"end": schema.StringAttribute{
+				Description: "End of the edition as an RFC3339 timestamp, which must be after the start and not " +
+					"after the end of the event",
+				Required:   true,
+				CustomType: timetypes.RFC3339Type{},
+			},
+			"active_end_date": schema.BoolAttribute{
+				Description: "Whether the content of the edition is unpublished at the end of the edition",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"publishing_status": schema.StringAttribute{
+				Description: "Publishing status of the edition, such as `DRAFT`, `SCHEDULED` or `PUBLISHED`. Only " +
+					"draft editions are changed by the provider",
+				Computed: true,
+			},
+		},
+	}
+}
+
+// ValidateConfig checks that the edition ends after it starts. Whether the edition is within the event is checked
+// during apply, since the event can change in the same plan.
+func (r *editionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config Edition
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := utils.ValidateTimeRange(config.Start, config.End); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("end"), "Invalid edition end", err.Error())
+	}
+}
+
+// appliedKey is the key in the private state of the values that were last applied to the edition, so a plan can tell
+// changes to the configuration apart from changes made in Amplience
+const appliedKey = "applied"
+
+// ModifyPlan prevents changes to the configuration of editions that are scheduled or published. Differences that
+// were made in Amplience only result in a warning, so they do not block the plan of other resources. Destroying such
+// an edition only removes it from the state.
+func (r *editionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var state Edition
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || !state.isLocked() {
+		return
+	}
+
+	if req.Plan.Raw.IsNull() {
+		resp.Diagnostics.AddWarning(
+			"Edition will not be deleted",
+			fmt.Sprintf("Edition %q is %s, so it will only be removed from the Terraform state.",
+				state.Name.ValueString(), state.PublishingStatus.ValueString()),
+		)
+		return
+	}
+
+	if req.Plan.Raw.Equal(req.State.Raw) {
+		return
+	}
+
+	var plan Edition
+	diags = req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var applied []byte
+	if req.Private != nil {
+		applied, diags = req.Private.GetKey(ctx, appliedKey)
+		resp.Diagnostics.Append(diags...)
+	}
+
+	if changed := changedAttributes(&plan, &state, applied); len(changed) > 0 {
+		resp.Diagnostics.AddError(
+			"Edition can no longer be changed",
+			fmt.Sprintf("Edition %q is %s in Amplience, so %s can no longer be changed by Terraform. Unschedule "+
+				"the edition in Amplience or revert the changes to the configuration.",
+				state.Name.ValueString(), state.PublishingStatus.ValueString(), strings.Join(changed, ", ")),
+		)
+		return
+	}
+
+	resp.Diagnostics.AddWarning(
+		"Edition differs from the configuration",
+		fmt.Sprintf("Edition %q was changed in Amplience and is %s, so Terraform will not update it. Update the "+
+			"configuration to match the edition in Amplience to remove this difference.",
+			state.Name.ValueString(), state.PublishingStatus.ValueString()),
+	)
+}
+
+// changedAttributes returns the attributes of plan that differ from applied, the values that were last applied to the
+// edition. These are the changes to the configuration, as opposed to changes made in Amplience. When applied is not
+// known, for example after an import, all differences are assumed to be made in Amplience.
+func changedAttributes(plan *Edition, state *Edition, applied []byte) []string {
+	var changed []string
+	if !plan.EventID.Equal(state.EventID) {
+		changed = append(changed, "event_id")
+	}
+
+	var previous api.Edition
+	if len(applied) == 0 || json.Unmarshal(applied, &previous) != nil {
+		return changed
+	}
+
+	current := plan.ToInput()
+	if current.Name != previous.Name {
+		changed = append(changed, "name")
+	}
+	if current.Start != previous.Start {
+		changed = append(changed, "start")
+	}
+	if current.End != previous.End {
+		changed = append(changed, "end")
+	}
+	if current.ActiveEndDate != previous.ActiveEndDate {
+		changed = append(changed, "active_end_date")
+	}
+	return changed
+}
+
+// appliedValue returns the private state value for appliedKey
+func appliedValue(plan *Edition) []byte {
+	value, _ := json.Marshal(plan.ToInput())
+	return value
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *editionResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	data := req.ProviderData.(*config.ClientInfo)
+	r.api = data.API
+}
+
+// checkEventWindow checks that the edition starts and ends within its event
+func (r *editionResource) checkEventWindow(ctx context.Context, plan *Edition) diag.Diagnostics {
+	var diags diag.Diagnostics
+	event, err := r.api.EventGet(ctx, plan.EventID.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("event_id"), "Failed to read event", utils.ErrorDetail(err))
+		return diags
+	}
+
+	eventStart, err := time.Parse(time.RFC3339, event.Start)
+	if err != nil {
+		diags.AddError("Failed to read event", fmt.Sprintf("invalid start %q: %s", event.Start, err))
+		return diags
+	}
+	eventEnd, err := time.Parse(time.RFC3339, event.End)
+	if err != nil {
+		diags.AddError("Failed to read event", fmt.Sprintf("invalid end %q: %s", event.End, err))
+		return diags
+	}
+
+	start, d := plan.Start.ValueRFC3339Time()
+	diags.Append(d...)
+	end, d := plan.End.ValueRFC3339Time()
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if start.Before(eventStart) {
+		diags.AddAttributeError(path.Root("start"), "Edition starts before its event",
+			fmt.Sprintf("The edition starts at %s, before the start of event %q at %s.",
+				plan.Start.ValueString(), event.Name, event.Start))
+	}
+	if end.After(eventEnd) {
+		diags.AddAttributeError(path.Root("end"), "Edition ends after its event",
+			fmt.Sprintf("The edition ends at %s, after the end of event %q at %s.",
+				plan.End.ValueString(), event.Name, event.End))
+	}
+	return diags
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *editionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan Edition
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.checkEventWindow(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	instance, err := r.api.EditionCreate(ctx, plan.EventID.ValueString(), plan.ToInput())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create edition", utils.ErrorDetail(err))
+		return
+	}
+
+	result, diags := NewEditionFromNative(plan.EventID.ValueString(), &instance)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Private != nil {
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, appliedKey, appliedValue(&plan))...)
+	}
+}
+
+// Read refreshes the Terraform state with the latest data, including the publishing status.
+func (r *editionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state Edition
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	instance, err := r.api.EditionGet(ctx, state.ID.ValueString())
+	if err != nil {
+		if utils.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Failed to read edition", utils.ErrorDetail(err))
+		return
+	}
+
+	current, diags := NewEditionFromNative(state.EventID.ValueString(), &instance)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, current)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *editionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan Edition
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state Edition
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// ModifyPlan only lets a locked edition through when it was changed in Amplience. It can no longer be updated,
+	// so the state follows the configuration until the edition is read again.
+	if state.isLocked() {
+		plan.PublishingStatus = state.PublishingStatus
+		diags = resp.State.Set(ctx, plan)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	resp.Diagnostics.Append(r.checkEventWindow(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	instance, err := r.api.EditionUpdate(ctx, plan.ID.ValueString(), plan.ToInput())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update edition", utils.ErrorDetail(err))
+		return
+	}
+
+	result, diags := NewEditionFromNative(plan.EventID.ValueString(), &instance)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Private != nil {
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, appliedKey, appliedValue(&plan))...)
+	}
+}
+
+// Delete deletes the edition, unless it was scheduled or published in the meantime.
+func (r *editionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state Edition
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	instance, err := r.api.EditionGet(ctx, state.ID.ValueString())
+	if err != nil {
+		if utils.IsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError("Failed to read edition", utils.ErrorDetail(err))
+		return
+	}
+
+	if instance.IsLocked() {
+		resp.Diagnostics.AddWarning(
+			"Edition not deleted",
+			fmt.Sprintf("Edition %q is %s, so it is removed from the Terraform state but still exists in Amplience.",
+				instance.Name, instance.PublishingStatus),
+		)
+		return
+	}
+
+	err = r.api.EditionDelete(ctx, state.ID.ValueString())
+	if err != nil && !utils.IsNotFound(err) {
+		resp.Diagnostics.AddError("Failed to delete edition", utils.ErrorDetail(err))
+	}
+}
+
+// ImportState imports an edition using its ID. The event_id is read from the edition.
+func (r *editionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
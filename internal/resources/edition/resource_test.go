@@ -0,0 +1,277 @@
+package edition
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/labd/terraform-provider-amplience/internal/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestResource(t *testing.T, handler http.Handler) (*editionResource, resource.SchemaResponse) {
+	ctx := context.Background()
+	ci := testutils.NewClientInfo(t, handler)
+
+	r := &editionResource{}
+	r.Configure(ctx, resource.ConfigureRequest{ProviderData: ci}, &resource.ConfigureResponse{})
+
+	schemaResp := resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	return r, schemaResp
+}
+
+func newTestState(t *testing.T, schemaResp resource.SchemaResponse, value *Edition) tfsdk.State {
+	ctx := context.Background()
+	state := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	diags := state.Set(ctx, value)
+	require.False(t, diags.HasError(), "%v", diags)
+	return state
+}
+
+func testEdition(status string) *Edition {
+	return &Edition{
+		ID:               types.StringValue("edition-id"),
+		EventID:          types.StringValue("event-id"),
+		Name:             types.StringValue("Early deals"),
+		Start:            timetypes.NewRFC3339ValueMust("2026-11-27T00:00:00Z"),
+		End:              timetypes.NewRFC3339ValueMust("2026-11-28T00:00:00Z"),
+		ActiveEndDate:    types.BoolValue(false),
+		PublishingStatus: types.StringValue(status),
+	}
+}
+
+var testEventResponse = map[string]interface{}{
+	"id":    "event-id",
+	"name":  "Black Friday",
+	"start": "2026-11-27T00:00:00.000Z",
+	"end":   "2026-11-30T23:59:59.000Z",
+}
+
+func TestEditionResourceCreate(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name    string
+		start   string
+		end     string
+		summary string
+	}{
+		{name: "within event", start: "2026-11-27T01:00:00+01:00", end: "2026-11-30T23:59:59Z"},
+		{name: "starts before event", start: "2026-11-26T23:00:00Z", end: "2026-11-28T00:00:00Z", summary: "Edition starts before its event"},
+		{name: "ends after event", start: "2026-11-27T00:00:00Z", end: "2026-12-01T00:00:00Z", summary: "Edition ends after its event"},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+
+			created := false
+			r, schemaResp := newTestResource(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch {
+				case r.Method == http.MethodGet && r.URL.Path == "/events/event-id":
+					testutils.WriteJSON(w, http.StatusOK, testEventResponse)
+				case r.Method == http.MethodPost && r.URL.Path == "/events/event-id/editions":
+					created = true
+					testutils.WriteJSON(w, http.StatusCreated, map[string]interface{}{
+						"id":               "edition-id",
+						"eventId":          "event-id",
+						"name":             "Early deals",
+						"start":            tc.start,
+						"end":              tc.end,
+						"publishingStatus": "DRAFT",
+					})
+				default:
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+				}
+			}))
+
+			planned := testEdition("")
+			planned.ID = types.StringUnknown()
+			planned.PublishingStatus = types.StringUnknown()
+			planned.Start = timetypes.NewRFC3339ValueMust(tc.start)
+			planned.End = timetypes.NewRFC3339ValueMust(tc.end)
+			plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: newTestState(t, schemaResp, planned).Raw}
+
+			resp := &resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+			r.Create(ctx, resource.CreateRequest{Plan: plan}, resp)
+
+			if tc.summary != "" {
+				require.True(t, resp.Diagnostics.HasError())
+				assert.Equal(t, tc.summary, resp.Diagnostics[0].Summary())
+				assert.False(t, created)
+				return
+			}
+
+			require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+			var result Edition
+			require.False(t, resp.State.Get(ctx, &result).HasError())
+			assert.Equal(t, "DRAFT", result.PublishingStatus.ValueString())
+		})
+	}
+}
+
+func TestEditionResourceModifyPlan(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name    string
+		status  string
+		destroy bool
+		rename  bool
+		move    bool
+		error   string
+		warning string
+	}{
+		{name: "draft", status: "DRAFT", rename: true},
+		{name: "published unchanged", status: "PUBLISHED"},
+		// Without the applied values in the private state, the difference is the name refreshed from Amplience
+		{name: "published with refreshed drift", status: "PUBLISHED", rename: true, warning: "Edition differs from the configuration"},
+		{name: "scheduled with refreshed drift", status: "SCHEDULED", rename: true, warning: "Edition differs from the configuration"},
+		{name: "scheduled moved to another event", status: "SCHEDULED", move: true, error: "Edition can no longer be changed"},
+		{name: "scheduled destroyed", status: "SCHEDULED", destroy: true, warning: "Edition will not be deleted"},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+			r, schemaResp := newTestResource(t, http.HandlerFunc(testutils.NotFound))
+
+			state := newTestState(t, schemaResp, testEdition(tc.status))
+			planned := testEdition(tc.status)
+			if tc.rename {
+				planned.Name = types.StringValue("Doorbusters")
+				planned.PublishingStatus = types.StringUnknown()
+			}
+			if tc.move {
+				planned.EventID = types.StringValue("other-event-id")
+			}
+			plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: newTestState(t, schemaResp, planned).Raw}
+			if tc.destroy {
+				plan.Raw = tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)
+			}
+
+			resp := &resource.ModifyPlanResponse{Plan: plan}
+			r.ModifyPlan(ctx, resource.ModifyPlanRequest{State: state, Plan: plan}, resp)
+
+			if tc.error != "" {
+				require.True(t, resp.Diagnostics.HasError())
+				assert.Equal(t, tc.error, resp.Diagnostics.Errors()[0].Summary())
+				return
+			}
+			require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+			if tc.warning != "" {
+				require.Len(t, resp.Diagnostics.Warnings(), 1)
+				assert.Equal(t, tc.warning, resp.Diagnostics.Warnings()[0].Summary())
+			} else {
+				assert.Empty(t, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestChangedAttributes(t *testing.T) {
+	t.Parallel()
+	state := testEdition("SCHEDULED")
+	applied := appliedValue(state)
+
+	// The configuration was not changed, the difference was made in Amplience
+	assert.Empty(t, changedAttributes(testEdition("SCHEDULED"), state, applied))
+	assert.Empty(t, changedAttributes(testEdition("SCHEDULED"), state, nil))
+
+	planned := testEdition("SCHEDULED")
+	planned.Name = types.StringValue("Doorbusters")
+	planned.ActiveEndDate = types.BoolValue(!state.ActiveEndDate.ValueBool())
+	assert.Equal(t, []string{"name", "active_end_date"}, changedAttributes(planned, state, applied))
+
+	planned = testEdition("SCHEDULED")
+	planned.EventID = types.StringValue("other-event-id")
+	assert.Equal(t, []string{"event_id"}, changedAttributes(planned, state, nil))
+}
+
+func TestEditionResourceUpdateLocked(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	r, schemaResp := newTestResource(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+	}))
+
+	state := newTestState(t, schemaResp, testEdition("PUBLISHED"))
+	planned := testEdition("PUBLISHED")
+	planned.Name = types.StringValue("Doorbusters")
+	planned.PublishingStatus = types.StringUnknown()
+	plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: newTestState(t, schemaResp, planned).Raw}
+
+	resp := &resource.UpdateResponse{State: state}
+	r.Update(ctx, resource.UpdateRequest{State: state, Plan: plan}, resp)
+	require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+
+	var result Edition
+	require.False(t, resp.State.Get(ctx, &result).HasError())
+	assert.Equal(t, "Doorbusters", result.Name.ValueString())
+	assert.Equal(t, "PUBLISHED", result.PublishingStatus.ValueString())
+}
+
+func TestEditionResourceReadPublished(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	r, schemaResp := newTestResource(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/editions/edition-id", r.URL.Path)
+		testutils.WriteJSON(w, http.StatusOK, map[string]interface{}{
+			"id":               "edition-id",
+			"eventId":          "event-id",
+			"name":             "Early deals",
+			"start":            "2026-11-27T00:00:00.000Z",
+			"end":              "2026-11-28T00:00:00.000Z",
+			"publishingStatus": "PUBLISHED",
+		})
+	}))
+
+	state := newTestState(t, schemaResp, testEdition("DRAFT"))
+	resp := &resource.ReadResponse{State: state}
+	r.Read(ctx, resource.ReadRequest{State: state}, resp)
+	require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+
+	var result Edition
+	require.False(t, resp.State.Get(ctx, &result).HasError())
+	assert.Equal(t, "PUBLISHED", result.PublishingStatus.ValueString())
+	assert.True(t, result.isLocked())
+}
+
+func TestEditionResourceDeleteScheduled(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	r, schemaResp := newTestResource(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/editions/edition-id":
+			testutils.WriteJSON(w, http.StatusOK, map[string]interface{}{
+				"id":               "edition-id",
+				"name":             "Early deals",
+				"publishingStatus": "SCHEDULED",
+			})
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+
+	state := newTestState(t, schemaResp, testEdition("DRAFT"))
+	resp := &resource.DeleteResponse{State: state}
+	r.Delete(ctx, resource.DeleteRequest{State: state}, resp)
+
+	require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+	require.Len(t, resp.Diagnostics.Warnings(), 1)
+	assert.Equal(t, "Edition not deleted", resp.Diagnostics.Warnings()[0].Summary())
+}
@@ -0,0 +1,53 @@
+package event
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/labd/terraform-provider-amplience/internal/api"
+)
+
+type Event struct {
+	ID      types.String      `tfsdk:"id"`
+	HubID   types.String      `tfsdk:"hub_id"`
+	Name    types.String      `tfsdk:"name"`
+	Start   timetypes.RFC3339 `tfsdk:"start"`
+	End     timetypes.RFC3339 `tfsdk:"end"`
+	Brief   types.String      `tfsdk:"brief"`
+	Comment types.String      `tfsdk:"comment"`
+}
+
+func NewEventFromNative(hubID string, event *api.Event) (*Event, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	result := &Event{
+		ID:      types.StringValue(event.ID),
+		HubID:   types.StringValue(hubID),
+		Name:    types.StringValue(event.Name),
+		Brief:   optionalString(event.Brief),
+		Comment: optionalString(event.Comment),
+	}
+
+	var d diag.Diagnostics
+	result.Start, d = timetypes.NewRFC3339Value(event.Start)
+	diags.Append(d...)
+	result.End, d = timetypes.NewRFC3339Value(event.End)
+	diags.Append(d...)
+	return result, diags
+}
+
+func (e *Event) ToInput() api.Event {
+	return api.Event{
+		Name:    e.Name.ValueString(),
+		Start:   e.Start.ValueString(),
+		End:     e.End.ValueString(),
+		Brief:   e.Brief.ValueString(),
+		Comment: e.Comment.ValueString(),
+	}
+}
+
+func optionalString(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
@@ -0,0 +1,221 @@
+package event
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/labd/terraform-provider-amplience/internal/api"
+	"github.com/labd/terraform-provider-amplience/internal/config"
+	"github.com/labd/terraform-provider-amplience/internal/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &eventResource{}
+	_ resource.ResourceWithConfigure      = &eventResource{}
+	_ resource.ResourceWithImportState    = &eventResource{}
+	_ resource.ResourceWithValidateConfig = &eventResource{}
+)
+
+// NewEventResource is a helper function to simplify the provider implementation.
+func NewEventResource() resource.Resource {
+	return &eventResource{}
+}
+
+// eventResource is the resource implementation.
+type eventResource struct {
+	api   *api.Client
+	hubId string
+}
+
+// Metadata returns the resource type name.
+func (r *eventResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_event"
+}
+
+// Schema defines the schema for the resource.
+func (r *eventResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "An event is a planned campaign, such as Black Friday or a seasonal launch, which " +
+			"contains the editions that are published during the event. Editions are managed with the " +
+			"`amplience_edition` resource.\n" +
+			"For more info see [Amplience Events Docs](https://amplience.com/developers/docs/user-guides/schedule-content/events-and-editions/)",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the event",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"hub_id": utils.HubIDAttribute(),
+			"name": schema.StringAttribute{
+				Description: "Name of the event",
+				Required:    true,
+				Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"start": schema.StringAttribute{
+				Description: "Start of the event as an RFC3339 timestamp, for example `2026-11-27T00:00:00Z`",
+				Required:    true,
+				CustomType:  timetypes.RFC3339Type{},
+			},
+			"end": schema.StringAttribute{
+				Description: "End of the event as an RFC3339 timestamp, which must be after the start",
+				Required:    true,
+				CustomType:  timetypes.RFC3339Type{},
+			},
+			"brief": schema.StringAttribute{
+				Description: "URL of the brief of the event",
+				Optional:    true,
+			},
+			"comment": schema.StringAttribute{
+				Description: "Comment on the event",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+// ValidateConfig checks that the event ends after it starts.
+func (r *eventResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config Event
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := utils.ValidateTimeRange(config.Start, config.End); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("end"), "Invalid event end", err.Error())
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *eventResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	data := req.ProviderData.(*config.ClientInfo)
+	r.api = data.API
+	r.hubId = data.HubID
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *eventResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan Event
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hubID, err := utils.RequireHubID(plan.HubID, r.hubId)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("hub_id"), "Missing hub ID", err.Error())
+		return
+	}
+	instance, err := r.api.EventCreate(ctx, hubID, plan.ToInput())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create event", utils.ErrorDetail(err))
+		return
+	}
+
+	result, diags := NewEventFromNative(hubID, &instance)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *eventResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state Event
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	instance, err := r.api.EventGet(ctx, state.ID.ValueString())
+	if err != nil {
+		if utils.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Failed to read event", utils.ErrorDetail(err))
+		return
+	}
+
+	current, diags := NewEventFromNative(utils.HubID(state.HubID, r.hubId), &instance)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, current)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *eventResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan Event
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	instance, err := r.api.EventUpdate(ctx, plan.ID.ValueString(), plan.ToInput())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update event", utils.ErrorDetail(err))
+		return
+	}
+
+	result, diags := NewEventFromNative(utils.HubID(plan.HubID, r.hubId), &instance)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the event. Amplience rejects this when the event has scheduled or published editions.
+func (r *eventResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state Event
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.api.EventDelete(ctx, state.ID.ValueString())
+	if err != nil && !utils.IsNotFound(err) {
+		resp.Diagnostics.AddError("Failed to delete event", utils.ErrorDetail(err))
+	}
+}
+
+// ImportState imports an event using either its ID or a <hub_id>:<id> ID
+func (r *eventResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	prefix, id := utils.ParseID(req.ID)
+	hubID, err := utils.RequireHubID(types.StringValue(prefix), r.hubId)
+	if err != nil {
+		resp.Diagnostics.AddError("Missing hub ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("hub_id"), types.StringValue(hubID))...)
+}
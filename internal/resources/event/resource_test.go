@@ -0,0 +1,108 @@
+package event
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/labd/terraform-provider-amplience/internal/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestResource(t *testing.T, handler http.Handler) (*eventResource, resource.SchemaResponse) {
+	ctx := context.Background()
+	ci := testutils.NewClientInfo(t, handler)
+
+	r := &eventResource{}
+	r.Configure(ctx, resource.ConfigureRequest{ProviderData: ci}, &resource.ConfigureResponse{})
+
+	schemaResp := resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	return r, schemaResp
+}
+
+func newTestState(t *testing.T, schemaResp resource.SchemaResponse, value *Event) tfsdk.State {
+	ctx := context.Background()
+	state := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	diags := state.Set(ctx, value)
+	require.False(t, diags.HasError(), "%v", diags)
+	return state
+}
+
+func testEvent() *Event {
+	return &Event{
+		ID:      types.StringValue("event-id"),
+		HubID:   types.StringValue(testutils.HubID),
+		Name:    types.StringValue("Black Friday"),
+		Start:   timetypes.NewRFC3339ValueMust("2026-11-27T00:00:00Z"),
+		End:     timetypes.NewRFC3339ValueMust("2026-11-30T23:59:59Z"),
+		Brief:   types.StringNull(),
+		Comment: types.StringValue("Deals on everything"),
+	}
+}
+
+func TestEventResourceCreate(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	var requests []string
+	r, schemaResp := newTestResource(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		requests = append(requests, r.Method+" "+r.URL.Path+" "+string(body))
+		testutils.WriteJSON(w, http.StatusCreated, map[string]interface{}{
+			"id":      "event-id",
+			"name":    "Black Friday",
+			"start":   "2026-11-27T00:00:00.000Z",
+			"end":     "2026-11-30T23:59:59.000Z",
+			"comment": "Deals on everything",
+		})
+	}))
+
+	planned := testEvent()
+	planned.ID = types.StringUnknown()
+	plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: newTestState(t, schemaResp, planned).Raw}
+
+	resp := &resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, resp)
+	require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+
+	assert.Equal(t, []string{
+		`POST /hubs/test-hub/events {"name":"Black Friday","start":"2026-11-27T00:00:00Z",` +
+			`"end":"2026-11-30T23:59:59Z","brief":"","comment":"Deals on everything"}`,
+	}, requests)
+
+	var result Event
+	require.False(t, resp.State.Get(ctx, &result).HasError())
+	assert.Equal(t, "event-id", result.ID.ValueString())
+	assert.True(t, result.Brief.IsNull())
+
+	equal, diags := result.Start.StringSemanticEquals(ctx, testEvent().Start)
+	require.False(t, diags.HasError())
+	assert.True(t, equal)
+}
+
+func TestEventResourceValidateConfig(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	r, schemaResp := newTestResource(t, http.HandlerFunc(testutils.NotFound))
+
+	value := testEvent()
+	value.End = timetypes.NewRFC3339ValueMust("2026-11-26T00:00:00Z")
+	config := tfsdk.Config{Schema: schemaResp.Schema, Raw: newTestState(t, schemaResp, value).Raw}
+
+	resp := &resource.ValidateConfigResponse{}
+	r.ValidateConfig(ctx, resource.ValidateConfigRequest{Config: config}, resp)
+
+	require.True(t, resp.Diagnostics.HasError())
+	assert.Equal(t, "Invalid event end", resp.Diagnostics[0].Summary())
+}
@@ -0,0 +1,179 @@
+package extension
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/labd/terraform-provider-amplience/internal/api"
+)
+
+type Extension struct {
+	ID                        types.String         `tfsdk:"id"`
+	HubID                     types.String         `tfsdk:"hub_id"`
+	Name                      types.String         `tfsdk:"name"`
+	Label                     types.String         `tfsdk:"label"`
+	URL                       types.String         `tfsdk:"url"`
+	Category                  types.String         `tfsdk:"category"`
+	Height                    types.Int64          `tfsdk:"height"`
+	EnabledForAllContentTypes types.Bool           `tfsdk:"enabled_for_all_content_types"`
+	Parameters                jsontypes.Normalized `tfsdk:"parameters"`
+	Snippets                  types.List           `tfsdk:"snippets"`
+	Permissions               types.Set            `tfsdk:"permissions"`
+	APIPermissions            types.Set            `tfsdk:"api_permissions"`
+}
+
+type Snippet struct {
+	Label types.String         `tfsdk:"label"`
+	Body  jsontypes.Normalized `tfsdk:"body"`
+}
+
+// snippetType is the type of the elements of the snippets list
+var snippetType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"label": types.StringType,
+		"body":  jsontypes.NormalizedType{},
+	},
+}
+
+// NewExtensionFromNative converts an extension of the API. The parameters are only set when current, the value in
+// the state, has parameters or the extension has parameters other than an empty object.
+func NewExtensionFromNative(ctx context.Context, hubID string, current *Extension, extension *api.Extension) (*Extension, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	result := &Extension{
+		ID:                        types.StringValue(extension.ID),
+		HubID:                     types.StringValue(hubID),
+		Name:                      types.StringValue(extension.Name),
+		Label:                     types.StringValue(extension.Label),
+		URL:                       types.StringValue(extension.URL),
+		Category:                  types.StringValue(extension.Category),
+		Height:                    types.Int64Null(),
+		EnabledForAllContentTypes: types.BoolValue(extension.EnabledForAllContentTypes),
+		Parameters:                jsontypes.NewNormalizedNull(),
+		Snippets:                  types.ListNull(snippetType),
+		Permissions:               types.SetNull(types.StringType),
+		APIPermissions:            types.SetNull(types.StringType),
+	}
+
+	if extension.Height != 0 {
+		result.Height = types.Int64Value(int64(extension.Height))
+	}
+
+	// The API returns an empty string for an extension without parameters, which is not valid JSON
+	parameters := extension.Parameters
+	if parameters == "" {
+		parameters = "{}"
+	}
+	if parameters != "{}" || (current != nil && !current.Parameters.IsNull()) {
+		result.Parameters = jsontypes.NewNormalizedValue(parameters)
+	}
+
+	if len(extension.Snippets) > 0 {
+		snippets := make([]Snippet, 0, len(extension.Snippets))
+		for _, snippet := range extension.Snippets {
+			snippets = append(snippets, Snippet{
+				Label: types.StringValue(snippet.Label),
+				Body:  jsontypes.NewNormalizedValue(snippet.Body),
+			})
+		}
+		var d diag.Diagnostics
+		result.Snippets, d = types.ListValueFrom(ctx, snippetType, snippets)
+		diags.Append(d...)
+	}
+
+	if extension.Settings != "" {
+		var settings api.ExtensionSettings
+		if err := json.Unmarshal([]byte(extension.Settings), &settings); err != nil {
+			diags.AddError("Failed to read extension",
+				fmt.Sprintf("the settings of extension %s are not valid JSON: %s", extension.ID, err))
+			return nil, diags
+		}
+		result.APIPermissions = enabledPermissions(settings.API)
+		result.Permissions = enabledPermissions(settings.Sandbox)
+	}
+
+	return result, diags
+}
+
+func (e *Extension) ToInput(ctx context.Context) (api.Extension, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var sandbox, apiAccess []string
+	diags.Append(e.Permissions.ElementsAs(ctx, &sandbox, false)...)
+	diags.Append(e.APIPermissions.ElementsAs(ctx, &apiAccess, false)...)
+
+	var snippets []Snippet
+	diags.Append(e.Snippets.ElementsAs(ctx, &snippets, false)...)
+	if diags.HasError() {
+		return api.Extension{}, diags
+	}
+
+	settings, err := json.Marshal(api.ExtensionSettings{
+		API:     permissionMap(apiAccess, apiPermissions),
+		Sandbox: permissionMap(sandbox, sandboxPermissions),
+	})
+	if err != nil {
+		diags.AddError("Failed to encode extension settings", err.Error())
+		return api.Extension{}, diags
+	}
+
+	input := api.Extension{
+		Name:                      e.Name.ValueString(),
+		Label:                     e.Label.ValueString(),
+		URL:                       e.URL.ValueString(),
+		Category:                  e.Category.ValueString(),
+		Height:                    int(e.Height.ValueInt64()),
+		EnabledForAllContentTypes: e.EnabledForAllContentTypes.ValueBool(),
+		Parameters:                "{}",
+		Snippets:                  []api.ExtensionSnippet{},
+		Settings:                  string(settings),
+	}
+	if !e.Parameters.IsNull() && !e.Parameters.IsUnknown() {
+		input.Parameters = e.Parameters.ValueString()
+	}
+	for _, snippet := range snippets {
+		input.Snippets = append(input.Snippets, api.ExtensionSnippet{
+			Label: snippet.Label.ValueString(),
+			Body:  snippet.Body.ValueString(),
+		})
+	}
+	return input, diags
+}
+
+// permissionMap returns the permissions as the map used in the settings of an extension, in which every known
+// permission is either enabled or disabled
+func permissionMap(enabled []string, known []string) map[string]bool {
+	result := make(map[string]bool, len(known))
+	for _, permission := range known {
+		result[permission] = false
+	}
+	for _, permission := range enabled {
+		result[permission] = true
+	}
+	return result
+}
+
+// enabledPermissions returns the enabled permissions in the settings of an extension, or null when none are enabled
+func enabledPermissions(permissions map[string]bool) types.Set {
+	var result []string
+	for permission, enabled := range permissions {
+		if enabled {
+			result = append(result, permission)
+		}
+	}
+	if len(result) == 0 {
+		return types.SetNull(types.StringType)
+	}
+
+	sort.Strings(result)
+	values := make([]attr.Value, len(result))
+	for i, permission := range result {
+		values[i] = types.StringValue(permission)
+	}
+	return types.SetValueMust(types.StringType, values)
+}
@@ -0,0 +1,300 @@
+package extension
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/labd/terraform-provider-amplience/internal/api"
+	"github.com/labd/terraform-provider-amplience/internal/config"
+	"github.com/labd/terraform-provider-amplience/internal/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &extensionResource{}
+	_ resource.ResourceWithConfigure   = &extensionResource{}
+	_ resource.ResourceWithImportState = &extensionResource{}
+)
+
+// sandboxPermissions are the iframe sandbox permissions an extension can be given
+var sandboxPermissions = []string{"SAME_ORIGIN", "MODALS", "NAVIGATION", "POPUPS", "DOWNLOADS", "FORMS"}
+
+// apiPermissions are the permissions an extension can be given to use the Dynamic Content APIs
+var apiPermissions = []string{"READ", "EDIT"}
+
+// NewExtensionResource is a helper function to simplify the provider implementation.
+func NewExtensionResource() resource.Resource {
+	return &extensionResource{}
+}
+
+// extensionResource is the resource implementation.
+type extensionResource struct {
+	api   *api.Client
+	hubId string
+}
+
+// Metadata returns the resource type name.
+func (r *extensionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_extension"
+}
+
+// Schema defines the schema for the resource.
+func (r *extensionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "A UI extension registered on a hub, such as a product picker, a dashboard or a custom " +
+			"content editor. Content type schemas use content field extensions by `name` in `ui:extension`.\n" +
+			"For more info see [Amplience Extension Docs](https://amplience.com/developers/docs/integrations/extensions/)",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the extension",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"hub_id": utils.HubIDAttribute(),
+			"name": schema.StringAttribute{
+				Description: "Unique name of the extension, which is used to refer to the extension in content type " +
+					"schemas",
+				Required:   true,
+				Validators: []validator.String{utils.NoWhitespace(), stringvalidator.LengthAtLeast(1)},
+			},
+			"label": schema.StringAttribute{
+				Description: "Label of the extension",
+				Required:    true,
+			},
+			"url": schema.StringAttribute{
+				Description: "URL the extension is loaded from",
+				Required:    true,
+				Validators:  []validator.String{utils.NoWhitespace(), stringvalidator.LengthAtLeast(1)},
+			},
+			"category": schema.StringAttribute{
+				Description: "Category of the extension, one of `CONTENT_FIELD`, `DASHBOARD` or `CONTENT_EDITOR`",
+				Required:    true,
+				Validators: []validator.String{stringvalidator.OneOf(
+					api.ExtensionCategoryContentField,
+					api.ExtensionCategoryDashboard,
+					api.ExtensionCategoryContentEditor,
+				)},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"height": schema.Int64Attribute{
+				Description: "Initial height of the extension in pixels",
+				Optional:    true,
+				Validators:  []validator.Int64{int64validator.AtLeast(1)},
+			},
+			"enabled_for_all_content_types": schema.BoolAttribute{
+				Description: "Whether a content editor extension is enabled for all content types",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"parameters": schema.StringAttribute{
+				Description: "A JSON object with the installation parameters of the extension. These often contain " +
+					"API keys, so the value is sensitive",
+				Optional:   true,
+				Sensitive:  true,
+				CustomType: jsontypes.NormalizedType{},
+			},
+			"snippets": schema.ListNestedAttribute{
+				Description: "Snippets are initial values an editor can choose from when using a content field " +
+					"extension",
+				Optional:   true,
+				Validators: []validator.List{listvalidator.SizeAtLeast(1)},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"label": schema.StringAttribute{
+							Description: "Label of the snippet",
+							Required:    true,
+						},
+						"body": schema.StringAttribute{
+							Description: "JSON value of the snippet",
+							Required:    true,
+							CustomType:  jsontypes.NormalizedType{},
+						},
+					},
+				},
+			},
+			"permissions": schema.SetAttribute{
+				Description: "Sandbox permissions of the extension, any of `SAME_ORIGIN`, `MODALS`, `NAVIGATION`, " +
+					"`POPUPS`, `DOWNLOADS` and `FORMS`",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(sandboxPermissions...)),
+				},
+			},
+			"api_permissions": schema.SetAttribute{
+				Description: "Permissions of the extension to use the Dynamic Content APIs, any of `READ` and `EDIT`",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(apiPermissions...)),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *extensionResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	data := req.ProviderData.(*config.ClientInfo)
+	r.api = data.API
+	r.hubId = data.HubID
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *extensionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan Extension
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input, diags := plan.ToInput(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hubID, err := utils.RequireHubID(plan.HubID, r.hubId)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("hub_id"), "Missing hub ID", err.Error())
+		return
+	}
+	instance, err := r.api.ExtensionCreate(ctx, hubID, input)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create extension", utils.ErrorDetail(err))
+		return
+	}
+
+	result, diags := newState(ctx, hubID, &plan, &instance)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+// newState returns the state for the extension returned by the API. The values of the plan are kept for the JSON
+// attributes, so their formatting does not change.
+func newState(ctx context.Context, hubID string, plan *Extension, instance *api.Extension) (*Extension, diag.Diagnostics) {
+	result, diags := NewExtensionFromNative(ctx, hubID, plan, instance)
+	if diags.HasError() {
+		return nil, diags
+	}
+	result.Parameters = plan.Parameters
+	result.Snippets = plan.Snippets
+	return result, diags
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *extensionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state Extension
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	instance, err := r.api.ExtensionGet(ctx, state.ID.ValueString())
+	if err != nil {
+		if utils.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Failed to read extension", utils.ErrorDetail(err))
+		return
+	}
+
+	current, diags := NewExtensionFromNative(ctx, utils.HubID(state.HubID, r.hubId), &state, &instance)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, current)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *extensionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan Extension
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input, diags := plan.ToInput(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	instance, err := r.api.ExtensionUpdate(ctx, plan.ID.ValueString(), input)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update extension", utils.ErrorDetail(err))
+		return
+	}
+
+	result, diags := newState(ctx, utils.HubID(plan.HubID, r.hubId), &plan, &instance)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the extension.
+func (r *extensionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state Extension
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.api.ExtensionDelete(ctx, state.ID.ValueString())
+	if err != nil && !utils.IsNotFound(err) {
+		resp.Diagnostics.AddError("Failed to delete extension", utils.ErrorDetail(err))
+	}
+}
+
+// ImportState imports an extension using either its ID or a <hub_id>:<id> ID
+func (r *extensionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	prefix, id := utils.ParseID(req.ID)
+	hubID, err := utils.RequireHubID(types.StringValue(prefix), r.hubId)
+	if err != nil {
+		resp.Diagnostics.AddError("Missing hub ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("hub_id"), types.StringValue(hubID))...)
+}
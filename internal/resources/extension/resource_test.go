@@ -0,0 +1,197 @@
+package extension
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/labd/terraform-provider-amplience/internal/api"
+	"github.com/labd/terraform-provider-amplience/internal/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestResource(t *testing.T, handler http.Handler) (*extensionResource, resource.SchemaResponse) {
+	ctx := context.Background()
+	ci := testutils.NewClientInfo(t, handler)
+
+	r := &extensionResource{}
+	r.Configure(ctx, resource.ConfigureRequest{ProviderData: ci}, &resource.ConfigureResponse{})
+
+	schemaResp := resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	return r, schemaResp
+}
+
+func newTestState(t *testing.T, schemaResp resource.SchemaResponse, value *Extension) tfsdk.State {
+	ctx := context.Background()
+	state := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	diags := state.Set(ctx, value)
+	require.False(t, diags.HasError(), "%v", diags)
+	return state
+}
+
+func testExtension() *Extension {
+	return &Extension{
+		ID:                        types.StringValue("extension-id"),
+		HubID:                     types.StringValue(testutils.HubID),
+		Name:                      types.StringValue("product-picker"),
+		Label:                     types.StringValue("Product picker"),
+		URL:                       types.StringValue("https://extensions.example.com/product-picker"),
+		Category:                  types.StringValue("CONTENT_FIELD"),
+		Height:                    types.Int64Value(400),
+		EnabledForAllContentTypes: types.BoolValue(false),
+		Parameters:                jsontypes.NewNormalizedValue(`{"apiKey": "secret"}`),
+		Snippets: types.ListValueMust(snippetType, []attr.Value{
+			types.ObjectValueMust(snippetType.AttrTypes, map[string]attr.Value{
+				"label": types.StringValue("Empty"),
+				"body":  jsontypes.NewNormalizedValue(`[]`),
+			}),
+		}),
+		Permissions:    testSet("MODALS", "SAME_ORIGIN"),
+		APIPermissions: testSet("READ"),
+	}
+}
+
+func testSet(values ...string) types.Set {
+	elements := make([]attr.Value, len(values))
+	for i, value := range values {
+		elements[i] = types.StringValue(value)
+	}
+	return types.SetValueMust(types.StringType, elements)
+}
+
+var testExtensionResponse = map[string]interface{}{
+	"id":                        "extension-id",
+	"name":                      "product-picker",
+	"label":                     "Product picker",
+	"url":                       "https://extensions.example.com/product-picker",
+	"category":                  "CONTENT_FIELD",
+	"height":                    400,
+	"enabledForAllContentTypes": false,
+	"parameters":                `{"apiKey":"secret"}`,
+	"snippets":                  []map[string]interface{}{{"label": "Empty", "body": "[]"}},
+	"settings":                  `{"API":{"EDIT":false,"READ":true},"SANDBOX":{"DOWNLOADS":false,"FORMS":false,"MODALS":true,"NAVIGATION":false,"POPUPS":false,"SAME_ORIGIN":true}}`,
+	"status":                    "ACTIVE",
+}
+
+func TestExtensionResourceCreate(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	var input map[string]interface{}
+	r, schemaResp := newTestResource(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/hubs/test-hub/extensions":
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&input))
+			testutils.WriteJSON(w, http.StatusCreated, testExtensionResponse)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+
+	planned := testExtension()
+	planned.ID = types.StringUnknown()
+	plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: newTestState(t, schemaResp, planned).Raw}
+
+	resp := &resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, resp)
+	require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+
+	assert.Equal(t, `{"apiKey": "secret"}`, input["parameters"])
+	assert.Equal(t, []interface{}{map[string]interface{}{"label": "Empty", "body": "[]"}}, input["snippets"])
+	assert.JSONEq(t, testExtensionResponse["settings"].(string), input["settings"].(string))
+
+	var result Extension
+	require.False(t, resp.State.Get(ctx, &result).HasError())
+	assert.Equal(t, *testExtension(), result)
+}
+
+func TestExtensionResourceRead(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	r, schemaResp := newTestResource(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/extensions/extension-id", r.URL.Path)
+		response := map[string]interface{}{}
+		for key, value := range testExtensionResponse {
+			response[key] = value
+		}
+		response["parameters"] = "{}"
+		response["settings"] = `{"API":{"READ":true,"EDIT":true},"SANDBOX":{"SAME_ORIGIN":true}}`
+		testutils.WriteJSON(w, http.StatusOK, response)
+	}))
+
+	current := testExtension()
+	current.Parameters = jsontypes.NewNormalizedNull()
+	state := newTestState(t, schemaResp, current)
+	resp := &resource.ReadResponse{State: state}
+	r.Read(ctx, resource.ReadRequest{State: state}, resp)
+	require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+
+	var result Extension
+	require.False(t, resp.State.Get(ctx, &result).HasError())
+	assert.True(t, result.Parameters.IsNull())
+	assert.Equal(t, testSet("EDIT", "READ"), result.APIPermissions)
+	assert.Equal(t, testSet("SAME_ORIGIN"), result.Permissions)
+}
+
+func TestNewExtensionFromNativeEmptyParameters(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	extension := &api.Extension{ID: "extension-id", Parameters: ""}
+
+	result, diags := NewExtensionFromNative(ctx, testutils.HubID, nil, extension)
+	require.False(t, diags.HasError(), "%v", diags)
+	assert.True(t, result.Parameters.IsNull())
+
+	// Parameters that were removed in Amplience show up as drift from the parameters in the state
+	result, diags = NewExtensionFromNative(ctx, testutils.HubID, testExtension(), extension)
+	require.False(t, diags.HasError(), "%v", diags)
+	assert.Equal(t, jsontypes.NewNormalizedValue("{}"), result.Parameters)
+
+	equal, diags := result.Parameters.StringSemanticEquals(ctx, testExtension().Parameters)
+	require.False(t, diags.HasError(), "%v", diags)
+	assert.False(t, equal)
+}
+
+func TestExtensionPlanWithUnknownValues(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	_, schemaResp := newTestResource(t, http.HandlerFunc(testutils.NotFound))
+
+	planned := testExtension()
+	planned.Snippets = types.ListUnknown(snippetType)
+	planned.Permissions = types.SetUnknown(types.StringType)
+	planned.APIPermissions = types.SetNull(types.StringType)
+	plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: newTestState(t, schemaResp, planned).Raw}
+
+	var result Extension
+	diags := plan.Get(ctx, &result)
+	require.False(t, diags.HasError(), "%v", diags)
+	assert.True(t, result.Snippets.IsUnknown())
+	assert.True(t, result.Permissions.IsUnknown())
+	assert.True(t, result.APIPermissions.IsNull())
+}
+
+func TestExtensionResourceReadRemoved(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	r, schemaResp := newTestResource(t, http.HandlerFunc(testutils.NotFound))
+
+	state := newTestState(t, schemaResp, testExtension())
+	resp := &resource.ReadResponse{State: state}
+	r.Read(ctx, resource.ReadRequest{State: state}, resp)
+
+	assert.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+	assert.True(t, resp.State.Raw.IsNull())
+}
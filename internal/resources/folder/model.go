@@ -0,0 +1,37 @@
+package folder
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/labd/terraform-provider-amplience/internal/api"
+)
+
+type Folder struct {
+	ID             types.String `tfsdk:"id"`
+	RepositoryID   types.String `tfsdk:"repository_id"`
+	ParentFolderID types.String `tfsdk:"parent_folder_id"`
+	Name           types.String `tfsdk:"name"`
+}
+
+// NewFolderFromNative converts a folder of the API. The repository and parent folder are taken from the links of the
+// folder, falling back to current when the API does not return them.
+func NewFolderFromNative(current *Folder, folder *api.Folder) *Folder {
+	result := &Folder{
+		ID:             types.StringValue(folder.ID),
+		RepositoryID:   current.RepositoryID,
+		ParentFolderID: current.ParentFolderID,
+		Name:           types.StringValue(folder.Name),
+	}
+	if id := folder.RepositoryID(); id != "" {
+		result.RepositoryID = types.StringValue(id)
+	}
+	if id := folder.ParentFolderID(); id != "" {
+		result.ParentFolderID = types.StringValue(id)
+	}
+	return result
+}
+
+func (f *Folder) ToInput() api.FolderInput {
+	return api.FolderInput{
+		Name: f.Name.ValueString(),
+	}
+}
@@ -0,0 +1,188 @@
+package folder
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/labd/terraform-provider-amplience/internal/api"
+	"github.com/labd/terraform-provider-amplience/internal/config"
+	"github.com/labd/terraform-provider-amplience/internal/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &folderResource{}
+	_ resource.ResourceWithConfigure   = &folderResource{}
+	_ resource.ResourceWithImportState = &folderResource{}
+)
+
+// NewFolderResource is a helper function to simplify the provider implementation.
+func NewFolderResource() resource.Resource {
+	return &folderResource{}
+}
+
+// folderResource is the resource implementation.
+type folderResource struct {
+	api *api.Client
+}
+
+// Metadata returns the resource type name.
+func (r *folderResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_folder"
+}
+
+// Schema defines the schema for the resource.
+func (r *folderResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "A folder in a content repository. Folders can be nested using `parent_folder_id`, and " +
+			"content items are placed in a folder using the `folder_id` of `amplience_content_item`. A folder can " +
+			"only be deleted when it is empty.\n" +
+			"For more info see [Amplience Folder Docs](https://amplience.com/developers/docs/apis/content-management-reference/#tag/Folders)",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the folder",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"repository_id": schema.StringAttribute{
+				Description: "ID of the content repository of the folder",
+				Required:    true,
+				Validators:  []validator.String{utils.NoWhitespace()},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"parent_folder_id": schema.StringAttribute{
+				Description: "ID of the folder to create this folder in. The folder is created in the root of the " +
+					"repository when this is not set",
+				Optional:   true,
+				Validators: []validator.String{utils.NoWhitespace()},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the folder. Renaming a folder does not replace it",
+				Required:    true,
+				Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *folderResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	data := req.ProviderData.(*config.ClientInfo)
+	r.api = data.API
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *folderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan Folder
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	instance, err := r.api.FolderCreate(ctx, plan.RepositoryID.ValueString(), plan.ParentFolderID.ValueString(), plan.ToInput())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create folder", utils.ErrorDetail(err))
+		return
+	}
+
+	result := NewFolderFromNative(&plan, &instance)
+	result.RepositoryID = plan.RepositoryID
+	result.ParentFolderID = plan.ParentFolderID
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *folderResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state Folder
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	instance, err := r.api.FolderGet(ctx, state.ID.ValueString())
+	if err != nil {
+		if utils.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Failed to read folder", utils.ErrorDetail(err))
+		return
+	}
+
+	diags = resp.State.Set(ctx, NewFolderFromNative(&state, &instance))
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update renames the folder, which is the only change that does not replace it.
+func (r *folderResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan Folder
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	instance, err := r.api.FolderUpdate(ctx, plan.ID.ValueString(), plan.ToInput())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to rename folder", utils.ErrorDetail(err))
+		return
+	}
+
+	result := NewFolderFromNative(&plan, &instance)
+	result.RepositoryID = plan.RepositoryID
+	result.ParentFolderID = plan.ParentFolderID
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the folder. Amplience rejects this when the folder still contains content items or folders.
+func (r *folderResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state Folder
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.api.FolderDelete(ctx, state.ID.ValueString())
+	if err != nil && !utils.IsNotFound(err) {
+		resp.Diagnostics.AddError("Failed to delete folder", utils.ErrorDetail(err))
+	}
+}
+
+// ImportState imports a folder using a <repository_id>:<folder_id> ID. The parent folder is read from the folder.
+func (r *folderResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	repositoryID, id := utils.ParseID(req.ID)
+	if repositoryID == "" || id == "" {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			fmt.Sprintf("Expected an import ID of the form <repository_id>:<folder_id>, got %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("repository_id"), repositoryID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
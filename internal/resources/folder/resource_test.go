@@ -0,0 +1,174 @@
+package folder
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/labd/terraform-provider-amplience/internal/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestResource(t *testing.T, handler http.Handler) (*folderResource, resource.SchemaResponse) {
+	ctx := context.Background()
+	ci := testutils.NewClientInfo(t, handler)
+
+	r := &folderResource{}
+	r.Configure(ctx, resource.ConfigureRequest{ProviderData: ci}, &resource.ConfigureResponse{})
+
+	schemaResp := resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	return r, schemaResp
+}
+
+func newTestState(t *testing.T, schemaResp resource.SchemaResponse, value *Folder) tfsdk.State {
+	ctx := context.Background()
+	state := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	diags := state.Set(ctx, value)
+	require.False(t, diags.HasError(), "%v", diags)
+	return state
+}
+
+func testFolder(name string) *Folder {
+	return &Folder{
+		ID:             types.StringValue("folder-id"),
+		RepositoryID:   types.StringValue("repository-id"),
+		ParentFolderID: types.StringValue("parent-id"),
+		Name:           types.StringValue(name),
+	}
+}
+
+func testFolderResponse(name string) map[string]interface{} {
+	return map[string]interface{}{
+		"id":   "folder-id",
+		"name": name,
+		"_links": map[string]interface{}{
+			"self":               map[string]interface{}{"href": "https://api.amplience.net/v2/content/folders/folder-id"},
+			"content-repository": map[string]interface{}{"href": "https://api.amplience.net/v2/content/content-repositories/repository-id"},
+			"parent-folder":      map[string]interface{}{"href": "https://api.amplience.net/v2/content/folders/parent-id"},
+			"folders":            map[string]interface{}{"href": "https://api.amplience.net/v2/content/folders/folder-id/folders{?page,size}"},
+		},
+	}
+}
+
+func TestFolderResourceCreate(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	testCases := []struct {
+		name     string
+		parentID types.String
+		request  string
+	}{
+		{name: "root", parentID: types.StringNull(), request: `POST /content-repositories/repository-id/folders {"name":"Navigation"}`},
+		{name: "subfolder", parentID: types.StringValue("parent-id"), request: `POST /folders/parent-id/folders {"name":"Navigation"}`},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var requests []string
+			r, schemaResp := newTestResource(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, _ := io.ReadAll(r.Body)
+				requests = append(requests, r.Method+" "+r.URL.Path+" "+string(body))
+				testutils.WriteJSON(w, http.StatusCreated, map[string]interface{}{"id": "folder-id", "name": "Navigation"})
+			}))
+
+			planned := testFolder("Navigation")
+			planned.ID = types.StringUnknown()
+			planned.ParentFolderID = tc.parentID
+			plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: newTestState(t, schemaResp, planned).Raw}
+
+			resp := &resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+			r.Create(ctx, resource.CreateRequest{Plan: plan}, resp)
+			require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+			assert.Equal(t, []string{tc.request}, requests)
+
+			var result Folder
+			require.False(t, resp.State.Get(ctx, &result).HasError())
+			assert.Equal(t, "folder-id", result.ID.ValueString())
+			assert.Equal(t, tc.parentID, result.ParentFolderID)
+		})
+	}
+}
+
+func TestFolderResourceUpdate(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	var requests []string
+	r, schemaResp := newTestResource(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		requests = append(requests, r.Method+" "+r.URL.Path+" "+string(body))
+		testutils.WriteJSON(w, http.StatusOK, testFolderResponse("Menus"))
+	}))
+
+	state := newTestState(t, schemaResp, testFolder("Navigation"))
+	plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: newTestState(t, schemaResp, testFolder("Menus")).Raw}
+
+	resp := &resource.UpdateResponse{State: state}
+	r.Update(ctx, resource.UpdateRequest{State: state, Plan: plan}, resp)
+	require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+
+	assert.Equal(t, []string{`PATCH /folders/folder-id {"name":"Menus"}`}, requests)
+
+	var result Folder
+	require.False(t, resp.State.Get(ctx, &result).HasError())
+	assert.Equal(t, "Menus", result.Name.ValueString())
+}
+
+func TestFolderResourceReadImported(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	r, schemaResp := newTestResource(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/folders/folder-id", r.URL.Path)
+		testutils.WriteJSON(w, http.StatusOK, testFolderResponse("Navigation"))
+	}))
+
+	imported := &resource.ImportStateResponse{State: newTestState(t, schemaResp, &Folder{})}
+	r.ImportState(ctx, resource.ImportStateRequest{ID: "repository-id:folder-id"}, imported)
+	require.False(t, imported.Diagnostics.HasError(), "%v", imported.Diagnostics)
+
+	resp := &resource.ReadResponse{State: imported.State}
+	r.Read(ctx, resource.ReadRequest{State: imported.State}, resp)
+	require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+
+	var result Folder
+	require.False(t, resp.State.Get(ctx, &result).HasError())
+	assert.Equal(t, *testFolder("Navigation"), result)
+}
+
+func TestFolderResourceImportInvalidID(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	r, schemaResp := newTestResource(t, http.HandlerFunc(testutils.NotFound))
+
+	resp := &resource.ImportStateResponse{State: newTestState(t, schemaResp, &Folder{})}
+	r.ImportState(ctx, resource.ImportStateRequest{ID: "folder-id"}, resp)
+
+	require.True(t, resp.Diagnostics.HasError())
+	assert.Equal(t, "Invalid import ID", resp.Diagnostics[0].Summary())
+}
+
+func TestFolderResourceDeleteRemoved(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	r, schemaResp := newTestResource(t, http.HandlerFunc(testutils.NotFound))
+
+	state := newTestState(t, schemaResp, testFolder("Navigation"))
+	resp := &resource.DeleteResponse{State: state}
+	r.Delete(ctx, resource.DeleteRequest{State: state}, resp)
+
+	assert.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+}
@@ -7,6 +7,7 @@ import (
 
 type Hub struct {
 	ID          types.String `tfsdk:"id"`
+	HubID       types.String `tfsdk:"hub_id"`
 	Name        types.String `tfsdk:"name"`
 	Label       types.String `tfsdk:"label"`
 	Description types.String `tfsdk:"description"`
@@ -91,6 +92,15 @@ func (h *Hub) setSecretValuesFromState(s Hub) {
 	h.Settings.Publishing.Platforms.AmplienceDAM.APISecret = s.Settings.Publishing.Platforms.AmplienceDAM.APISecret
 }
 
+// OmitSecrets clears the DAM publishing secret, for when the hub is only read and no state holds the secret
+func (h *Hub) OmitSecrets() {
+	if h.Settings == nil || h.Settings.Publishing == nil || h.Settings.Publishing.Platforms == nil || h.Settings.Publishing.Platforms.AmplienceDAM == nil {
+		return
+	}
+
+	h.Settings.Publishing.Platforms.AmplienceDAM.APISecret = types.StringNull()
+}
+
 func (s *Settings) ToUpdateInput() *content.Settings {
 	if s == nil {
 		return nil
@@ -214,6 +224,7 @@ func (a *AssetManagement) ToUpdateInput() *content.AssetManagementSettings {
 func NewHubFromNative(hub *content.Hub) *Hub {
 	return &Hub{
 		ID:          types.StringValue(hub.ID),
+		HubID:       types.StringValue(hub.ID),
 		Name:        types.StringValue(hub.Name),
 		Label:       types.StringValue(hub.Label),
 		Description: types.StringPointerValue(hub.Description),
@@ -2,7 +2,6 @@ package hub
 
 import (
 	"context"
-	"fmt"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -10,7 +9,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/labd/amplience-go-sdk/content"
-	"github.com/labd/terraform-provider-amplience/amplience"
+	"github.com/labd/terraform-provider-amplience/internal/config"
+	"github.com/labd/terraform-provider-amplience/internal/utils"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -56,6 +56,7 @@ func (r *hubResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *
 			"id": schema.StringAttribute{
 				Computed: true,
 			},
+			"hub_id": hubIDAttribute(),
 			"name": schema.StringAttribute{
 				Description: "Hub name",
 				Required:    true,
@@ -185,11 +186,18 @@ func (r *hubResource) Configure(_ context.Context, req resource.ConfigureRequest
 	if req.ProviderData == nil {
 		return
 	}
-	data := req.ProviderData.(*amplience.ClientInfo)
+	data := req.ProviderData.(*config.ClientInfo)
 	r.client = data.Client
 	r.hubId = data.HubID
 }
 
+// hubIDAttribute is the shared hub_id attribute, which is the hub that is managed by this resource
+func hubIDAttribute() schema.StringAttribute {
+	attribute := utils.HubIDAttribute()
+	attribute.Description = "ID of the Hub to manage. Defaults to the `hub_id` of the provider"
+	return attribute
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *hubResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan Hub
@@ -199,15 +207,20 @@ func (r *hubResource) Create(ctx context.Context, req resource.CreateRequest, re
 		return
 	}
 
-	hub, err := r.client.HubGet(r.hubId)
+	hubID, err := utils.RequireHubID(plan.HubID, r.hubId)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("hub_id"), "Missing hub ID", err.Error())
+		return
+	}
+
+	hub, err := r.client.HubGet(hubID)
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to get hub", err.Error())
 		return
 	}
 	current := NewHubFromNative(&hub)
-	fmt.Println("current", current)
 
-	hub, err = r.client.HubPatch(r.hubId, current.ToUpdateInput())
+	hub, err = r.client.HubPatch(hubID, current.ToUpdateInput())
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to update hub", err.Error())
 		return
@@ -235,6 +248,10 @@ func (r *hubResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 
 	res, err := r.client.HubGet(state.ID.ValueString())
 	if err != nil {
+		if utils.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError("Error reading hub", err.Error())
 		return
 	}
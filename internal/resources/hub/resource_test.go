@@ -0,0 +1,44 @@
+package hub
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/labd/terraform-provider-amplience/internal/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHubResourceReadNotFound(t *testing.T) {
+	ctx := context.Background()
+	ci := testutils.NewClientInfo(t, http.HandlerFunc(testutils.NotFound))
+
+	r := &hubResource{}
+	r.Configure(ctx, resource.ConfigureRequest{ProviderData: ci}, &resource.ConfigureResponse{})
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	state := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	diags := state.Set(ctx, &Hub{
+		ID:    types.StringValue("5f7d5f7d5f7d5f7d5f7d5f7d"),
+		HubID: types.StringValue("5f7d5f7d5f7d5f7d5f7d5f7d"),
+		Name:  types.StringValue("my-hub"),
+		Label: types.StringValue("My Hub"),
+	})
+	require.False(t, diags.HasError(), "%v", diags)
+
+	resp := &resource.ReadResponse{State: state}
+	r.Read(ctx, resource.ReadRequest{State: state}, resp)
+
+	assert.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+	assert.True(t, resp.State.Raw.IsNull())
+}
@@ -0,0 +1,47 @@
+package search_index_replica
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/labd/amplience-go-sdk/content"
+	"github.com/labd/terraform-provider-amplience/internal/api"
+)
+
+type SearchIndexReplica struct {
+	ID       types.String         `tfsdk:"id"`
+	HubID    types.String         `tfsdk:"hub_id"`
+	IndexID  types.String         `tfsdk:"index_id"`
+	Label    types.String         `tfsdk:"label"`
+	Suffix   types.String         `tfsdk:"suffix"`
+	Name     types.String         `tfsdk:"name"`
+	Settings jsontypes.Normalized `tfsdk:"settings"`
+}
+
+// NewSearchIndexReplicaFromNative returns the replica without its settings, which are read separately
+func NewSearchIndexReplicaFromNative(hubID string, indexID string, index *content.AlgoliaIndex) *SearchIndexReplica {
+	if index.ParentID != "" {
+		indexID = index.ParentID
+	}
+
+	return &SearchIndexReplica{
+		ID:       types.StringValue(index.ID),
+		HubID:    types.StringValue(hubID),
+		IndexID:  types.StringValue(indexID),
+		Label:    types.StringValue(index.Label),
+		Suffix:   types.StringValue(index.Suffix),
+		Name:     types.StringValue(index.Name),
+		Settings: jsontypes.NewNormalizedNull(),
+	}
+}
+
+func (r *SearchIndexReplica) ToInput() api.AlgoliaReplicaInput {
+	return api.AlgoliaReplicaInput{
+		Suffix: r.Suffix.ValueString(),
+		Label:  r.Label.ValueString(),
+	}
+}
+
+// hasSettings returns whether the settings of the replica are managed
+func (r *SearchIndexReplica) hasSettings() bool {
+	return !r.Settings.IsNull() && !r.Settings.IsUnknown()
+}
@@ -0,0 +1,302 @@
+package search_index_replica
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/labd/amplience-go-sdk/content"
+	"github.com/labd/terraform-provider-amplience/internal/api"
+	"github.com/labd/terraform-provider-amplience/internal/config"
+	"github.com/labd/terraform-provider-amplience/internal/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &searchIndexReplicaResource{}
+	_ resource.ResourceWithConfigure   = &searchIndexReplicaResource{}
+	_ resource.ResourceWithImportState = &searchIndexReplicaResource{}
+)
+
+// NewSearchIndexReplicaResource is a helper function to simplify the provider implementation.
+func NewSearchIndexReplicaResource() resource.Resource {
+	return &searchIndexReplicaResource{}
+}
+
+// searchIndexReplicaResource is the resource implementation.
+type searchIndexReplicaResource struct {
+	client *content.Client
+	api    *api.Client
+	hubId  string
+}
+
+// Metadata returns the resource type name.
+func (r *searchIndexReplicaResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_search_index_replica"
+}
+
+// Schema defines the schema for the resource.
+func (r *searchIndexReplicaResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "A replica of an Algolia search index, which contains the same records as the index but " +
+			"can have different settings, for example to sort the results differently. Replicas are created for a " +
+			"primary index managed with the `amplience_search_index` resource.\n" +
+			"For more info see [Amplience Index Docs](https://amplience.com/docs/development/search-indexes/readme.html)",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the replica",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"hub_id": utils.HubIDAttribute(),
+			"index_id": schema.StringAttribute{
+				Description: "ID of the primary search index to create the replica of",
+				Required:    true,
+				Validators:  []validator.String{utils.NoWhitespace()},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"label": schema.StringAttribute{
+				Description: "Label for the replica",
+				Required:    true,
+			},
+			"suffix": schema.StringAttribute{
+				Description: "Suffix for the replica, which is added to the name of the primary index",
+				Required:    true,
+				Validators:  []validator.String{utils.NoWhitespace()},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the replica in Algolia",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"settings": schema.StringAttribute{
+				Description: "A JSON string containing Algolia settings " +
+					"(https://www.algolia.com/doc/api-reference/api-parameters/). Only the settings in this JSON are " +
+					"managed and compared with the settings of the replica",
+				Optional:   true,
+				CustomType: jsontypes.NormalizedType{},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *searchIndexReplicaResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	data := req.ProviderData.(*config.ClientInfo)
+	r.client = data.Client
+	r.api = data.API
+	r.hubId = data.HubID
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *searchIndexReplicaResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan SearchIndexReplica
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hubID, err := utils.RequireHubID(plan.HubID, r.hubId)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("hub_id"), "Missing hub ID", err.Error())
+		return
+	}
+	instance, err := r.api.AlgoliaReplicaCreate(ctx, hubID, plan.IndexID.ValueString(), plan.ToInput())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create search index replica", utils.ErrorDetail(err))
+		return
+	}
+
+	if plan.hasSettings() {
+		err = r.api.AlgoliaIndexSettingsUpdate(ctx, hubID, instance.ID, json.RawMessage(plan.Settings.ValueString()))
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to update the settings of search index replica", utils.ErrorDetail(err))
+
+			// Remove the replica, since it is not stored in the state
+			if _, err := r.client.AlgoliaIndexDelete(hubID, instance.ID); err != nil {
+				resp.Diagnostics.AddError("Failed to remove search index replica", utils.ErrorDetail(err))
+			}
+			return
+		}
+	}
+
+	result := NewSearchIndexReplicaFromNative(hubID, plan.IndexID.ValueString(), &instance)
+	result.Settings = plan.Settings
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *searchIndexReplicaResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state SearchIndexReplica
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hubID := utils.HubID(state.HubID, r.hubId)
+	instance, err := r.client.AlgoliaIndexGet(hubID, state.ID.ValueString())
+	if err != nil {
+		if utils.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Failed to read search index replica", utils.ErrorDetail(err))
+		return
+	}
+
+	current := NewSearchIndexReplicaFromNative(hubID, state.IndexID.ValueString(), &instance)
+	current.Settings, diags = r.readSettings(ctx, hubID, instance.ID, state.Settings)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, current)
+	resp.Diagnostics.Append(diags...)
+}
+
+// readSettings returns the settings of the replica that are set in current, the settings in the state. The replica
+// has a value for every Algolia setting, so only the managed settings are compared.
+func (r *searchIndexReplicaResource) readSettings(ctx context.Context, hubID string, id string, current jsontypes.Normalized) (jsontypes.Normalized, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if current.IsNull() || current.IsUnknown() {
+		return jsontypes.NewNormalizedNull(), diags
+	}
+
+	remote, err := r.api.AlgoliaIndexSettingsGet(ctx, hubID, id)
+	if err != nil {
+		diags.AddError("Failed to read the settings of search index replica", utils.ErrorDetail(err))
+		return current, diags
+	}
+
+	settings, err := utils.ManagedSettings(current.ValueString(), remote)
+	if err != nil {
+		tflog.Warn(ctx, "the settings in the state are not a JSON object, ignoring them", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return jsontypes.NewNormalizedNull(), diags
+	}
+	return jsontypes.NewNormalizedValue(settings), diags
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *searchIndexReplicaResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var state SearchIndexReplica
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var plan SearchIndexReplica
+	diags = req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hubID := utils.HubID(state.HubID, r.hubId)
+	instance, err := r.client.AlgoliaIndexGet(hubID, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read search index replica", utils.ErrorDetail(err))
+		return
+	}
+
+	// Only the label of a replica can be updated, a change of the suffix replaces the replica
+	if !plan.Label.Equal(state.Label) {
+		instance, err = r.client.AlgoliaIndexUpdate(hubID, instance, content.AlgoliaIndexInput{
+			Label: plan.Label.ValueString(),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to update search index replica", utils.ErrorDetail(err))
+			return
+		}
+	}
+
+	settingsEqual, diags := plan.Settings.StringSemanticEquals(ctx, state.Settings)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.hasSettings() && !settingsEqual {
+		err = r.api.AlgoliaIndexSettingsUpdate(ctx, hubID, instance.ID, json.RawMessage(plan.Settings.ValueString()))
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to update the settings of search index replica", utils.ErrorDetail(err))
+			return
+		}
+	}
+
+	result := NewSearchIndexReplicaFromNative(hubID, plan.IndexID.ValueString(), &instance)
+	result.Settings = plan.Settings
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the replica.
+func (r *searchIndexReplicaResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state SearchIndexReplica
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.AlgoliaIndexDelete(utils.HubID(state.HubID, r.hubId), state.ID.ValueString())
+	if err != nil && !utils.IsNotFound(err) {
+		resp.Diagnostics.AddError("Failed to delete search index replica", utils.ErrorDetail(err))
+	}
+}
+
+// ImportState imports a replica using either its ID or a <hub_id>:<id> ID. The index_id is read from the replica.
+// All settings of the replica are imported, like for a search index, so the next plan shows the differences with the
+// settings in the config.
+func (r *searchIndexReplicaResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	prefix, id := utils.ParseID(req.ID)
+	hubID, err := utils.RequireHubID(types.StringValue(prefix), r.hubId)
+	if err != nil {
+		resp.Diagnostics.AddError("Missing hub ID", err.Error())
+		return
+	}
+
+	settings, err := r.api.AlgoliaIndexSettingsGet(ctx, hubID, id)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read the settings of search index replica", utils.ErrorDetail(err))
+		return
+	}
+	value, err := json.Marshal(settings)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read the settings of search index replica", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("hub_id"), types.StringValue(hubID))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("settings"), jsontypes.NewNormalizedValue(string(value)))...)
+}
@@ -0,0 +1,188 @@
+package search_index_replica
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/labd/terraform-provider-amplience/internal/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestResource(t *testing.T, handler http.Handler) (*searchIndexReplicaResource, resource.SchemaResponse) {
+	ctx := context.Background()
+	ci := testutils.NewClientInfo(t, handler)
+
+	r := &searchIndexReplicaResource{}
+	r.Configure(ctx, resource.ConfigureRequest{ProviderData: ci}, &resource.ConfigureResponse{})
+
+	schemaResp := resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	return r, schemaResp
+}
+
+func newTestState(t *testing.T, schemaResp resource.SchemaResponse, value *SearchIndexReplica) tfsdk.State {
+	ctx := context.Background()
+	state := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	diags := state.Set(ctx, value)
+	require.False(t, diags.HasError(), "%v", diags)
+	return state
+}
+
+func testReplica(settings string) *SearchIndexReplica {
+	return &SearchIndexReplica{
+		ID:       types.StringValue("replica-id"),
+		HubID:    types.StringValue(testutils.HubID),
+		IndexID:  types.StringValue("index-id"),
+		Label:    types.StringValue("Products by price"),
+		Suffix:   types.StringValue("by-price"),
+		Name:     types.StringValue("test-hub.products-by-price"),
+		Settings: jsontypes.NewNormalizedValue(settings),
+	}
+}
+
+var testReplicaResponse = map[string]interface{}{
+	"id":       "replica-id",
+	"parentId": "index-id",
+	"label":    "Products by price",
+	"suffix":   "by-price",
+	"name":     "test-hub.products-by-price",
+}
+
+func TestSearchIndexReplicaResourceCreate(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	var requests []string
+	r, schemaResp := newTestResource(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		requests = append(requests, r.Method+" "+r.URL.Path+" "+string(body))
+
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/algolia-search/test-hub/indexes/index-id/replicas":
+			testutils.WriteJSON(w, http.StatusCreated, testReplicaResponse)
+		case r.Method == http.MethodPatch && r.URL.Path == "/algolia-search/test-hub/indexes/replica-id/settings":
+			testutils.WriteJSON(w, http.StatusOK, json.RawMessage(body))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+
+	planned := testReplica(`{"customRanking": ["asc(price)"]}`)
+	planned.ID = types.StringUnknown()
+	planned.Name = types.StringUnknown()
+	plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: newTestState(t, schemaResp, planned).Raw}
+
+	resp := &resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, resp)
+	require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+
+	assert.Equal(t, []string{
+		`POST /algolia-search/test-hub/indexes/index-id/replicas {"suffix":"by-price","label":"Products by price"}`,
+		`PATCH /algolia-search/test-hub/indexes/replica-id/settings {"customRanking": ["asc(price)"]}`,
+	}, requests)
+
+	var result SearchIndexReplica
+	require.False(t, resp.State.Get(ctx, &result).HasError())
+	assert.Equal(t, "replica-id", result.ID.ValueString())
+	assert.Equal(t, "test-hub.products-by-price", result.Name.ValueString())
+	assert.Equal(t, `{"customRanking": ["asc(price)"]}`, result.Settings.ValueString())
+}
+
+func TestSearchIndexReplicaResourceCreateWithoutHub(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	r, schemaResp := newTestResource(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+	}))
+	r.hubId = ""
+
+	planned := testReplica(`{}`)
+	planned.ID = types.StringUnknown()
+	planned.HubID = types.StringUnknown()
+	plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: newTestState(t, schemaResp, planned).Raw}
+
+	resp := &resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, resp)
+	require.True(t, resp.Diagnostics.HasError())
+	assert.Equal(t, "Missing hub ID", resp.Diagnostics[0].Summary())
+}
+
+func TestSearchIndexReplicaResourceRead(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	r, schemaResp := newTestResource(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/algolia-search/test-hub/indexes/replica-id":
+			testutils.WriteJSON(w, http.StatusOK, testReplicaResponse)
+		case "/algolia-search/test-hub/indexes/replica-id/settings":
+			testutils.WriteJSON(w, http.StatusOK, map[string]interface{}{
+				"customRanking": []string{"desc(price)"},
+				"hitsPerPage":   20,
+			})
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+
+	state := newTestState(t, schemaResp, testReplica(`{"customRanking": ["asc(price)"]}`))
+	resp := &resource.ReadResponse{State: state}
+	r.Read(ctx, resource.ReadRequest{State: state}, resp)
+	require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+
+	var result SearchIndexReplica
+	require.False(t, resp.State.Get(ctx, &result).HasError())
+	assert.Equal(t, "index-id", result.IndexID.ValueString())
+	assert.Equal(t, `{"customRanking":["desc(price)"]}`, result.Settings.ValueString())
+}
+
+func TestSearchIndexReplicaResourceReadRemoved(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	r, schemaResp := newTestResource(t, http.HandlerFunc(testutils.NotFound))
+
+	state := newTestState(t, schemaResp, testReplica(`{}`))
+	resp := &resource.ReadResponse{State: state}
+	r.Read(ctx, resource.ReadRequest{State: state}, resp)
+
+	assert.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+	assert.True(t, resp.State.Raw.IsNull())
+}
+
+func TestSearchIndexReplicaResourceImportState(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	r, schemaResp := newTestResource(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/algolia-search/other-hub/indexes/replica-id/settings", r.URL.Path)
+		testutils.WriteJSON(w, http.StatusOK, map[string]interface{}{
+			"customRanking": []string{"desc(price)"},
+			"hitsPerPage":   20,
+		})
+	}))
+
+	resp := &resource.ImportStateResponse{
+		State: tfsdk.State{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+		},
+	}
+	r.ImportState(ctx, resource.ImportStateRequest{ID: "other-hub:replica-id"}, resp)
+	require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+
+	var result SearchIndexReplica
+	require.False(t, resp.State.Get(ctx, &result).HasError())
+	assert.Equal(t, "replica-id", result.ID.ValueString())
+	assert.Equal(t, "other-hub", result.HubID.ValueString())
+	assert.Equal(t, `{"customRanking":["desc(price)"],"hitsPerPage":20}`, result.Settings.ValueString())
+}
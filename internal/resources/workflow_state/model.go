@@ -0,0 +1,29 @@
+package workflow_state
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/labd/terraform-provider-amplience/internal/api"
+)
+
+type WorkflowState struct {
+	ID    types.String `tfsdk:"id"`
+	HubID types.String `tfsdk:"hub_id"`
+	Label types.String `tfsdk:"label"`
+	Color types.String `tfsdk:"color"`
+}
+
+func NewWorkflowStateFromNative(hubID string, state *api.WorkflowState) *WorkflowState {
+	return &WorkflowState{
+		ID:    types.StringValue(state.ID),
+		HubID: types.StringValue(hubID),
+		Label: types.StringValue(state.Label),
+		Color: types.StringValue(state.Color),
+	}
+}
+
+func (w *WorkflowState) ToInput() api.WorkflowState {
+	return api.WorkflowState{
+		Label: w.Label.ValueString(),
+		Color: w.Color.ValueString(),
+	}
+}
@@ -0,0 +1,177 @@
+package workflow_state
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/labd/terraform-provider-amplience/internal/api"
+	"github.com/labd/terraform-provider-amplience/internal/config"
+	"github.com/labd/terraform-provider-amplience/internal/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &workflowStateResource{}
+	_ resource.ResourceWithConfigure   = &workflowStateResource{}
+	_ resource.ResourceWithImportState = &workflowStateResource{}
+)
+
+// NewWorkflowStateResource is a helper function to simplify the provider implementation.
+func NewWorkflowStateResource() resource.Resource {
+	return &workflowStateResource{}
+}
+
+// workflowStateResource is the resource implementation.
+type workflowStateResource struct {
+	api   *api.Client
+	hubId string
+}
+
+// Metadata returns the resource type name.
+func (r *workflowStateResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workflow_state"
+}
+
+// Schema defines the schema for the resource.
+func (r *workflowStateResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "An editorial workflow state of a hub, such as \"In review\" or \"Approved\". The " +
+			"Amplience API does not support deleting workflow states, so destroying this resource only removes it " +
+			"from the Terraform state.\n" +
+			"For more info see [Amplience Workflow Docs](https://amplience.com/developers/docs/user-guides/produce-content/workflow/)",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the workflow state",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"hub_id": utils.HubIDAttribute(),
+			"label": schema.StringAttribute{
+				Description: "Label of the workflow state",
+				Required:    true,
+				Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"color": schema.StringAttribute{
+				Description: "Color of the workflow state in the Dynamic Content app, for example `rgb(255,165,0)`",
+				Required:    true,
+				Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *workflowStateResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	data := req.ProviderData.(*config.ClientInfo)
+	r.api = data.API
+	r.hubId = data.HubID
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *workflowStateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan WorkflowState
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hubID, err := utils.RequireHubID(plan.HubID, r.hubId)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("hub_id"), "Missing hub ID", err.Error())
+		return
+	}
+	instance, err := r.api.WorkflowStateCreate(ctx, hubID, plan.ToInput())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create workflow state", utils.ErrorDetail(err))
+		return
+	}
+
+	diags = resp.State.Set(ctx, NewWorkflowStateFromNative(hubID, &instance))
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *workflowStateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state WorkflowState
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	instance, err := r.api.WorkflowStateGet(ctx, state.ID.ValueString())
+	if err != nil {
+		if utils.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Failed to read workflow state", utils.ErrorDetail(err))
+		return
+	}
+
+	diags = resp.State.Set(ctx, NewWorkflowStateFromNative(utils.HubID(state.HubID, r.hubId), &instance))
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *workflowStateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan WorkflowState
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	instance, err := r.api.WorkflowStateUpdate(ctx, plan.ID.ValueString(), plan.ToInput())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update workflow state", utils.ErrorDetail(err))
+		return
+	}
+
+	diags = resp.State.Set(ctx, NewWorkflowStateFromNative(utils.HubID(plan.HubID, r.hubId), &instance))
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete only removes the workflow state from the Terraform state, since the Amplience API does not support deleting
+// workflow states.
+func (r *workflowStateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state WorkflowState
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.AddWarning(
+		"Workflow state not deleted",
+		fmt.Sprintf("The Amplience API does not support deleting workflow states. Workflow state %q (%s) is removed "+
+			"from the Terraform state, but still exists in the hub.", state.Label.ValueString(), state.ID.ValueString()),
+	)
+}
+
+// ImportState imports a workflow state using either its ID or a <hub_id>:<id> ID
+func (r *workflowStateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	prefix, id := utils.ParseID(req.ID)
+	hubID, err := utils.RequireHubID(types.StringValue(prefix), r.hubId)
+	if err != nil {
+		resp.Diagnostics.AddError("Missing hub ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("hub_id"), types.StringValue(hubID))...)
+}
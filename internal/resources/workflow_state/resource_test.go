@@ -0,0 +1,107 @@
+package workflow_state
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/labd/terraform-provider-amplience/internal/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestResource(t *testing.T, handler http.Handler) (*workflowStateResource, resource.SchemaResponse) {
+	ctx := context.Background()
+	ci := testutils.NewClientInfo(t, handler)
+
+	r := &workflowStateResource{}
+	r.Configure(ctx, resource.ConfigureRequest{ProviderData: ci}, &resource.ConfigureResponse{})
+
+	schemaResp := resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	return r, schemaResp
+}
+
+func newTestState(t *testing.T, schemaResp resource.SchemaResponse, value *WorkflowState) tfsdk.State {
+	ctx := context.Background()
+	state := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	diags := state.Set(ctx, value)
+	require.False(t, diags.HasError(), "%v", diags)
+	return state
+}
+
+func testWorkflowState() *WorkflowState {
+	return &WorkflowState{
+		ID:    types.StringValue("state-id"),
+		HubID: types.StringValue(testutils.HubID),
+		Label: types.StringValue("In review"),
+		Color: types.StringValue("rgb(255,165,0)"),
+	}
+}
+
+func TestWorkflowStateResourceCreate(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	var requests []string
+	r, schemaResp := newTestResource(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		requests = append(requests, r.Method+" "+r.URL.Path+" "+string(body))
+		testutils.WriteJSON(w, http.StatusCreated, map[string]interface{}{
+			"id":    "state-id",
+			"label": "In review",
+			"color": "rgb(255,165,0)",
+		})
+	}))
+
+	planned := testWorkflowState()
+	planned.ID = types.StringUnknown()
+	plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: newTestState(t, schemaResp, planned).Raw}
+
+	resp := &resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, resp)
+	require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+
+	assert.Equal(t, []string{`POST /hubs/test-hub/workflow-states {"label":"In review","color":"rgb(255,165,0)"}`}, requests)
+
+	var result WorkflowState
+	require.False(t, resp.State.Get(ctx, &result).HasError())
+	assert.Equal(t, *testWorkflowState(), result)
+}
+
+func TestWorkflowStateResourceReadRemoved(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	r, schemaResp := newTestResource(t, http.HandlerFunc(testutils.NotFound))
+
+	state := newTestState(t, schemaResp, testWorkflowState())
+	resp := &resource.ReadResponse{State: state}
+	r.Read(ctx, resource.ReadRequest{State: state}, resp)
+
+	assert.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+	assert.True(t, resp.State.Raw.IsNull())
+}
+
+func TestWorkflowStateResourceDelete(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	r, schemaResp := newTestResource(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+	}))
+
+	state := newTestState(t, schemaResp, testWorkflowState())
+	resp := &resource.DeleteResponse{State: state}
+	r.Delete(ctx, resource.DeleteRequest{State: state}, resp)
+
+	require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+	require.Len(t, resp.Diagnostics.Warnings(), 1)
+	assert.Equal(t, "Workflow state not deleted", resp.Diagnostics.Warnings()[0].Summary())
+}
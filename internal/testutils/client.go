@@ -0,0 +1,59 @@
+package testutils
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labd/terraform-provider-amplience/internal/config"
+)
+
+const HubID = "test-hub"
+
+// NewClientInfo returns a ClientInfo for a fake Amplience API served by handler. The OAuth token endpoint is handled
+// by the fake server itself.
+func NewClientInfo(t *testing.T, handler http.Handler) *config.ClientInfo {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/oauth/token", func(w http.ResponseWriter, r *http.Request) {
+		WriteJSON(w, http.StatusOK, map[string]interface{}{
+			"access_token": "test-token",
+			"token_type":   "bearer",
+			"expires_in":   3600,
+		})
+	})
+	mux.Handle("/", handler)
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	ci, err := config.NewClientInfoFromHTTPClient(&config.Config{
+		ClientID:      "client-id",
+		ClientSecret:  "client-secret",
+		ContentAPIURL: server.URL,
+		AuthURL:       server.URL + "/oauth/token",
+		HubID:         HubID,
+	}, server.Client())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return ci
+}
+
+// WriteJSON writes body as a JSON response with the given status code
+func WriteJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+// NotFound responds like the Amplience API does for objects that do not exist
+func NotFound(w http.ResponseWriter, _ *http.Request) {
+	WriteJSON(w, http.StatusNotFound, map[string]interface{}{
+		"errors": []map[string]interface{}{
+			{"message": "Not Found"},
+		},
+	})
+}
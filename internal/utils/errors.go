@@ -0,0 +1,93 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/labd/amplience-go-sdk/content"
+)
+
+// IsNotFound returns true when err is an Amplience API error response with status 404, which means the object was
+// deleted outside of Terraform.
+func IsNotFound(err error) bool {
+	var errResp *content.ErrorResponse
+	return errors.As(err, &errResp) && errResp.StatusCode == http.StatusNotFound
+}
+
+// IsArchived returns true for the status of archived objects. For objects that can only be archived, not deleted,
+// such as content types and schemas, this means the object is gone.
+func IsArchived(status string) bool {
+	return status == string(content.StatusArchived)
+}
+
+// IsConflict returns true when err is an Amplience API error response with status 409, which is returned when an
+// object with the same unique key (such as a content type URI or schema ID) already exists.
+func IsConflict(err error) bool {
+	var errResp *content.ErrorResponse
+	return errors.As(err, &errResp) && errResp.StatusCode == http.StatusConflict
+}
+
+// duplicateMessages are the phrases in the messages of the API for a unique key that is already taken
+var duplicateMessages = []string{"already exists", "already registered"}
+
+// IsAlreadyExists returns true when err is the response to creating an object whose unique key, the request property
+// property (such as contentTypeUri), is already taken. Depending on the endpoint the API responds with status 409, or
+// with status 400 and an error that says the key already exists. Other 400 errors for property, such as an invalid
+// value, are validation errors and do not count.
+func IsAlreadyExists(err error, property string) bool {
+	var errResp *content.ErrorResponse
+	if !errors.As(err, &errResp) {
+		return false
+	}
+
+	switch errResp.StatusCode {
+	case http.StatusConflict:
+		return true
+	case http.StatusBadRequest:
+		for _, item := range errResp.Errors {
+			if item.Property != "" && item.Property != property {
+				continue
+			}
+			message := strings.ToLower(item.Message)
+			for _, duplicate := range duplicateMessages {
+				if strings.Contains(message, duplicate) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// ErrorDetail describes err for use in a diagnostic. For Amplience API error responses this includes the status code
+// and every error returned by the API, instead of only the first message.
+func ErrorDetail(err error) string {
+	var errResp *content.ErrorResponse
+	if !errors.As(err, &errResp) {
+		return err.Error()
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "The Amplience API returned status %d %s", errResp.StatusCode, http.StatusText(errResp.StatusCode))
+	for _, item := range errResp.Errors {
+		b.WriteString("\n- ")
+		b.WriteString(item.Message)
+
+		var fields []string
+		if item.Entity != "" {
+			fields = append(fields, "entity: "+item.Entity)
+		}
+		if item.Property != "" {
+			fields = append(fields, "property: "+item.Property)
+		}
+		if item.InvalidValue != "" {
+			fields = append(fields, fmt.Sprintf("invalid value: %q", item.InvalidValue))
+		}
+		if len(fields) > 0 {
+			fmt.Fprintf(&b, " (%s)", strings.Join(fields, ", "))
+		}
+	}
+	return b.String()
+}
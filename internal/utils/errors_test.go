@@ -0,0 +1,100 @@
+package utils
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/labd/amplience-go-sdk/content"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsNotFound(t *testing.T) {
+	notFound := &content.ErrorResponse{
+		StatusCode: http.StatusNotFound,
+		Errors:     []content.ErrorObject{{Message: "Not found"}},
+	}
+	forbidden := &content.ErrorResponse{
+		StatusCode: http.StatusForbidden,
+		Errors:     []content.ErrorObject{{Message: "Forbidden"}},
+	}
+
+	assert.True(t, IsNotFound(notFound))
+	assert.True(t, IsNotFound(fmt.Errorf("reading webhook: %w", notFound)))
+	assert.False(t, IsNotFound(forbidden))
+	assert.False(t, IsNotFound(fmt.Errorf("connection refused")))
+	assert.False(t, IsNotFound(nil))
+}
+
+func TestIsArchived(t *testing.T) {
+	assert.True(t, IsArchived("ARCHIVED"))
+	assert.False(t, IsArchived("ACTIVE"))
+	assert.False(t, IsArchived(""))
+}
+
+func TestIsConflict(t *testing.T) {
+	conflict := &content.ErrorResponse{
+		StatusCode: http.StatusConflict,
+		Errors:     []content.ErrorObject{{Message: "Conflict"}},
+	}
+	badRequest := &content.ErrorResponse{
+		StatusCode: http.StatusBadRequest,
+		Errors:     []content.ErrorObject{{Message: "Bad request"}},
+	}
+
+	assert.True(t, IsConflict(conflict))
+	assert.True(t, IsConflict(fmt.Errorf("creating content type: %w", conflict)))
+	assert.False(t, IsConflict(badRequest))
+	assert.False(t, IsConflict(fmt.Errorf("connection refused")))
+	assert.False(t, IsConflict(nil))
+}
+
+func TestIsAlreadyExists(t *testing.T) {
+	duplicate := &content.ErrorResponse{
+		StatusCode: http.StatusBadRequest,
+		Errors: []content.ErrorObject{{
+			Entity:       "ContentType",
+			Property:     "contentTypeUri",
+			InvalidValue: "https://schema.example.com/banner.json",
+			Message:      "Content type with this URI is already registered",
+		}},
+	}
+	otherProperty := &content.ErrorResponse{
+		StatusCode: http.StatusBadRequest,
+		Errors:     []content.ErrorObject{{Property: "settings.label", Message: "must not be empty"}},
+	}
+
+	assert.True(t, IsAlreadyExists(duplicate, "contentTypeUri"))
+	assert.True(t, IsAlreadyExists(fmt.Errorf("creating content type: %w", duplicate), "contentTypeUri"))
+	assert.True(t, IsAlreadyExists(&content.ErrorResponse{StatusCode: http.StatusConflict}, "contentTypeUri"))
+	assert.True(t, IsAlreadyExists(&content.ErrorResponse{
+		StatusCode: http.StatusBadRequest,
+		Errors:     []content.ErrorObject{{Message: "Schema already exists"}},
+	}, "schemaId"))
+	assert.False(t, IsAlreadyExists(otherProperty, "contentTypeUri"))
+	assert.False(t, IsAlreadyExists(&content.ErrorResponse{
+		StatusCode: http.StatusBadRequest,
+		Errors:     []content.ErrorObject{{Property: "contentTypeUri", Message: "must be a valid URI"}},
+	}, "contentTypeUri"))
+	assert.False(t, IsAlreadyExists(&content.ErrorResponse{StatusCode: http.StatusForbidden}, "contentTypeUri"))
+	assert.False(t, IsAlreadyExists(fmt.Errorf("connection refused"), "contentTypeUri"))
+	assert.False(t, IsAlreadyExists(nil, "contentTypeUri"))
+}
+
+func TestErrorDetail(t *testing.T) {
+	err := &content.ErrorResponse{
+		StatusCode: http.StatusBadRequest,
+		Errors: []content.ErrorObject{
+			{Message: "must be a valid URI", Entity: "ContentType", Property: "contentTypeUri", InvalidValue: "not a uri"},
+			{Message: "must not be empty", Property: "settings.label"},
+		},
+	}
+
+	assert.Equal(t,
+		"The Amplience API returned status 400 Bad Request\n"+
+			"- must be a valid URI (entity: ContentType, property: contentTypeUri, invalid value: \"not a uri\")\n"+
+			"- must not be empty (property: settings.label)",
+		ErrorDetail(err),
+	)
+	assert.Equal(t, "connection refused", ErrorDetail(fmt.Errorf("connection refused")))
+}
@@ -0,0 +1,133 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/mailgun/raymond/v2"
+	"github.com/mailgun/raymond/v2/lexer"
+)
+
+// HandlebarsError is a syntax error in a Handlebars template, such as the custom payload of a webhook. Line and
+// Column are 1-based; Column is 0 when the position on the line is not known, and Line is 0 when the position is not
+// known at all.
+type HandlebarsError struct {
+	Line    int
+	Column  int
+	Message string
+}
+
+func (e *HandlebarsError) Error() string {
+	if e.Line == 0 {
+		return e.Message
+	}
+	if e.Column == 0 {
+		return fmt.Sprintf("line %d: %s", e.Line, e.Message)
+	}
+	return fmt.Sprintf("line %d, column %d: %s", e.Line, e.Column, e.Message)
+}
+
+var (
+	handlebarsLine  = regexp.MustCompile(`^Parse error on line (\d+):$`)
+	handlebarsNode  = regexp.MustCompile(`^Node: .*\bPos:(\d+)\}$`)
+	handlebarsToken = regexp.MustCompile(`^(?:Token: (.*)|(Expecting .*), got: '(.*)')$`)
+)
+
+// ValidateHandlebars parses a Handlebars template without rendering it. A syntax error is returned as a
+// *HandlebarsError.
+func ValidateHandlebars(template string) error {
+	_, err := raymond.Parse(template)
+	if err == nil {
+		return nil
+	}
+	return newHandlebarsError(template, err)
+}
+
+// RenderHandlebars renders a Handlebars template with the data of an event, in the same way Amplience renders the
+// custom payload of a webhook. Only the built-in Handlebars helpers are available.
+func RenderHandlebars(template string, data interface{}) (string, error) {
+	tpl, err := raymond.Parse(template)
+	if err != nil {
+		return "", newHandlebarsError(template, err)
+	}
+	return tpl.Exec(data)
+}
+
+// newHandlebarsError converts the error of the Handlebars parser, which only contains the line and the offending
+// token or node, to an error with the line and column of the problem. The parser has no error types for this, so the
+// position is taken from the message. When the message does not have the expected format, the whole message is kept.
+func newHandlebarsError(template string, err error) error {
+	lines := strings.Split(err.Error(), "\n")
+	match := handlebarsLine.FindStringSubmatch(lines[0])
+	if match == nil || len(lines) == 1 {
+		return &HandlebarsError{Message: err.Error()}
+	}
+
+	line, _ := strconv.Atoi(match[1])
+	result := &HandlebarsError{Line: line}
+	fallback := &HandlebarsError{Line: line, Message: strings.Join(lines[1:], ": ")}
+
+	var messages []string
+	for i, text := range lines[1:] {
+		if match := handlebarsNode.FindStringSubmatch(text); match != nil {
+			pos, _ := strconv.Atoi(match[1])
+			result.Line, result.Column = handlebarsPosition(template, pos)
+			continue
+		}
+
+		match := handlebarsToken.FindStringSubmatch(text)
+		if match == nil {
+			// Only the first line after the position is a message, the parser adds the node or token after it
+			if i > 0 {
+				return fallback
+			}
+			messages = append(messages, text)
+			continue
+		}
+
+		token := match[1]
+		if match[2] != "" {
+			messages = append(messages, fmt.Sprintf("%s, got %s", match[2], match[3]))
+			token = match[3]
+		}
+		if tok, ok := findHandlebarsToken(template, line, token); ok {
+			result.Line, result.Column = handlebarsPosition(template, tok.Pos)
+			if tok.Kind == lexer.TokenError {
+				messages = append(messages, tok.Val)
+			}
+		}
+	}
+
+	result.Message = strings.Join(messages, ": ")
+	if result.Message == "" {
+		return fallback
+	}
+	return result
+}
+
+// findHandlebarsToken finds the token on the line with the string representation used in parser errors
+func findHandlebarsToken(template string, line int, token string) (lexer.Token, bool) {
+	for _, tok := range lexer.Collect(template) {
+		if tok.Line == line && tok.String() == token {
+			return tok, true
+		}
+	}
+	return lexer.Token{}, false
+}
+
+// handlebarsPosition converts a byte offset to a line and column
+func handlebarsPosition(template string, pos int) (int, int) {
+	before := template[:min(max(pos, 0), len(template))]
+	lineStart := strings.LastIndexByte(before, '\n') + 1
+	return strings.Count(before, "\n") + 1, utf8.RuneCountInString(before[lineStart:]) + 1
+}
+
+// IsHandlebarsError returns whether the error is a syntax error in a Handlebars template
+func IsHandlebarsError(err error) bool {
+	var target *HandlebarsError
+	return errors.As(err, &target)
+}
@@ -0,0 +1,112 @@
+package utils
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateHandlebars(t *testing.T) {
+	t.Parallel()
+	tcs := []struct {
+		Name     string
+		Template string
+		Error    string
+	}{
+		{
+			Name:     "Valid template",
+			Template: "{\n  \"id\": \"{{payload.id}}\",\n  {{#if payload.label}}\"label\": \"{{payload.label}}\"{{/if}}\n}",
+		},
+		{
+			Name:     "Unclosed expression",
+			Template: "{\n  \"id\": \"{{payload.id}\"\n}",
+			Error:    "line 2, column 22: Lexer error: Unexpected character in expression: '}'",
+		},
+		{
+			Name:     "Mismatching block",
+			Template: "{{#if payload.label}}\n  {{payload.label}}\n{{/each}}",
+			Error:    "line 3, column 4: if doesn't match each",
+		},
+		{
+			Name:     "Unclosed block",
+			Template: "{{#each payload.tags}}\n  {{this}}",
+			Error:    "line 2, column 11: Expecting OpenEndBlock, got EOF",
+		},
+		{
+			Name:     "Closing block without opening block",
+			Template: "{}\n{{/if}}",
+			Error:    "line 2, column 1: Syntax error",
+		},
+	}
+
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			err := ValidateHandlebars(tc.Template)
+			if tc.Error == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.True(t, IsHandlebarsError(err))
+			assert.Equal(t, tc.Error, err.Error())
+		})
+	}
+}
+
+func TestNewHandlebarsErrorFallsBackToMessage(t *testing.T) {
+	t.Parallel()
+	tcs := []struct {
+		Name    string
+		Message string
+		Error   string
+	}{
+		{
+			Name:    "Message without position",
+			Message: "template is too complex",
+			Error:   "template is too complex",
+		},
+		{
+			Name:    "Unknown details",
+			Message: "Parse error on line 2:\nUnexpected block\nBlock: if",
+			Error:   "line 2: Unexpected block: Block: if",
+		},
+		{
+			Name:    "Unknown token",
+			Message: "Parse error on line 1:\nToken: Mustache{{",
+			Error:   "line 1: Token: Mustache{{",
+		},
+	}
+
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			err := newHandlebarsError("{{#if}}\n{{/if}}", errors.New(tc.Message))
+			assert.True(t, IsHandlebarsError(err))
+			assert.Equal(t, tc.Error, err.Error())
+		})
+	}
+}
+
+func TestRenderHandlebars(t *testing.T) {
+	t.Parallel()
+	result, err := RenderHandlebars(
+		`{"id": "{{payload.id}}", "name": "{{{payload.name}}}"{{#each payload.tags}}, "tag": "{{this}}"{{/each}} }`,
+		map[string]interface{}{
+			"payload": map[string]interface{}{
+				"id":   "abc",
+				"name": "<b>",
+				"tags": []interface{}{"new"},
+			},
+		},
+	)
+	require.NoError(t, err)
+	assert.Equal(t, `{"id": "abc", "name": "<b>", "tag": "new" }`, result)
+
+	_, err = RenderHandlebars("{{#if}}", nil)
+	assert.True(t, IsHandlebarsError(err))
+}
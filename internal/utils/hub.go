@@ -0,0 +1,79 @@
+package utils
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var noWhitespace = regexp.MustCompile(`^\S*$`)
+
+// NoWhitespace validates that a string attribute does not contain any whitespace
+func NoWhitespace() validator.String {
+	return stringvalidator.RegexMatches(noWhitespace, "must not contain whitespace")
+}
+
+// HubIDAttribute returns the schema of the optional hub_id attribute of hub-scoped resources
+func HubIDAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: "ID of the Hub to manage this resource in. Defaults to the `hub_id` of the provider",
+		Optional:    true,
+		Computed:    true,
+		Validators:  []validator.String{NoWhitespace()},
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+// HubID returns the value of a hub_id attribute, falling back to the hub_id of the provider when it is not set. This
+// is also the case for resources imported or created before hub_id existed.
+func HubID(value types.String, fallback string) string {
+	if value.IsNull() || value.IsUnknown() || value.ValueString() == "" {
+		return fallback
+	}
+	return value.ValueString()
+}
+
+// ParseID splits an ID of the form <parent_id>:<resource_id>, such as the <hub_id>:<resource_id> import IDs of
+// hub-scoped resources. The returned parent ID is empty when the ID is just the ID of the resource. The resource ID can
+// be a URI, such as a content type URI, in which case the scheme of the URI is not mistaken for the parent ID.
+func ParseID(id string) (parentID string, resourceID string) {
+	if i := strings.Index(id, "://"); i >= 0 {
+		if j := strings.LastIndex(id[:i], ":"); j >= 0 {
+			return id[:j], id[j+1:]
+		}
+		return "", id
+	}
+
+	values := strings.SplitN(id, ":", 2)
+	if len(values) > 1 {
+		return values[0], values[1]
+	}
+	return "", values[0]
+}
+
+// CreateID joins a parent ID and a resource ID into an ID that ParseID splits again
+func CreateID(parentID string, resourceID string) string {
+	return parentID + ":" + resourceID
+}
+
+// ErrMissingHubID is returned by RequireHubID when neither the resource nor the provider sets a hub_id
+var ErrMissingHubID = errors.New("no hub_id is set on the resource or the provider. Set hub_id on one of them, or import the resource with a <hub_id>:<id> ID")
+
+// RequireHubID is HubID for the places where a hub is chosen, such as Create and import. Since the hub_id of the
+// provider is optional, it returns ErrMissingHubID when neither the resource nor the provider sets one.
+func RequireHubID(value types.String, fallback string) (string, error) {
+	if hubID := HubID(value, fallback); hubID != "" {
+		return hubID, nil
+	}
+	return "", ErrMissingHubID
+}
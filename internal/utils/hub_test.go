@@ -0,0 +1,50 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHubID(t *testing.T) {
+	assert.Equal(t, "other-hub", HubID(types.StringValue("other-hub"), "provider-hub"))
+	assert.Equal(t, "provider-hub", HubID(types.StringNull(), "provider-hub"))
+	assert.Equal(t, "provider-hub", HubID(types.StringUnknown(), "provider-hub"))
+	assert.Equal(t, "provider-hub", HubID(types.StringValue(""), "provider-hub"))
+}
+
+func TestParseID(t *testing.T) {
+	hubID, resourceID := ParseID("my-hub:my-id")
+	assert.Equal(t, "my-hub", hubID)
+	assert.Equal(t, "my-id", resourceID)
+
+	hubID, resourceID = ParseID("my-id")
+	assert.Equal(t, "", hubID)
+	assert.Equal(t, "my-id", resourceID)
+
+	hubID, resourceID = ParseID("https://schema.example.com/banner.json")
+	assert.Equal(t, "", hubID)
+	assert.Equal(t, "https://schema.example.com/banner.json", resourceID)
+
+	hubID, resourceID = ParseID("my-hub:https://schema.example.com/banner.json")
+	assert.Equal(t, "my-hub", hubID)
+	assert.Equal(t, "https://schema.example.com/banner.json", resourceID)
+
+	repositoryID, contentTypeID := ParseID(CreateID("repository-id", "content-type-id"))
+	assert.Equal(t, "repository-id", repositoryID)
+	assert.Equal(t, "content-type-id", contentTypeID)
+}
+
+func TestRequireHubID(t *testing.T) {
+	hubID, err := RequireHubID(types.StringValue("other-hub"), "")
+	assert.NoError(t, err)
+	assert.Equal(t, "other-hub", hubID)
+
+	hubID, err = RequireHubID(types.StringNull(), "provider-hub")
+	assert.NoError(t, err)
+	assert.Equal(t, "provider-hub", hubID)
+
+	_, err = RequireHubID(types.StringUnknown(), "")
+	assert.ErrorIs(t, err, ErrMissingHubID)
+}
@@ -0,0 +1,80 @@
+package utils
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+var amplienceID = regexp.MustCompile(`^[0-9a-f]{24}$`)
+
+// IsAmplienceID returns whether id looks like an ID generated by Amplience, as opposed to a natural key such as a
+// content type URI or a repository name
+func IsAmplienceID(id string) bool {
+	return amplienceID.MatchString(id)
+}
+
+// FindUnique returns the only item whose key equals value. kind and attribute describe the items and the key in the
+// error returned when no item or more than one item matches, for example "content type" and "content_type_uri".
+func FindUnique[T any](items []T, key func(T) string, id func(T) string, value string, kind string, attribute string) (T, error) {
+	var matches []T
+	for _, item := range items {
+		if key(item) == value {
+			matches = append(matches, item)
+		}
+	}
+
+	var result T
+	switch len(matches) {
+	case 0:
+		return result, fmt.Errorf("no %s found with %s %q", kind, attribute, value)
+	case 1:
+		return matches[0], nil
+	}
+
+	ids := make([]string, 0, len(matches))
+	for _, match := range matches {
+		ids = append(ids, id(match))
+	}
+	return result, fmt.Errorf("%s %q matches more than one %s: %s. Use the ID of one of them instead",
+		attribute, value, kind, strings.Join(ids, ", "))
+}
+
+// FindActive returns the only active item whose key equals value, like FindUnique. Archived items are skipped, as
+// they are removed from the state on refresh. When only archived items match, the error says so, so the item can be
+// unarchived in Amplience first.
+func FindActive[T any](items []T, key func(T) string, id func(T) string, status func(T) string, value string, kind string, attribute string) (T, error) {
+	var active []T
+	archived := false
+	for _, item := range items {
+		if !IsArchived(status(item)) {
+			active = append(active, item)
+		} else if key(item) == value {
+			archived = true
+		}
+	}
+
+	if archived && !slices.ContainsFunc(active, func(item T) bool { return key(item) == value }) {
+		var result T
+		return result, fmt.Errorf("the %s with %s %q is archived. Unarchive it in Amplience before importing it",
+			kind, attribute, value)
+	}
+	return FindUnique(active, key, id, value, kind, attribute)
+}
+
+// ResolveImportID returns the ID of the object to import by key, which is either its ID or a natural key such as a
+// content type URI. A key that looks like an Amplience ID is used as the ID, unless get reports that no object has
+// that ID: then it is resolved as a key as well, so an object with a key that looks like an ID can be imported.
+func ResolveImportID(key string, get func(id string) error, resolve func(key string) (string, error)) (string, error) {
+	if IsAmplienceID(key) {
+		err := get(key)
+		if err == nil {
+			return key, nil
+		}
+		if !IsNotFound(err) {
+			return "", err
+		}
+	}
+	return resolve(key)
+}
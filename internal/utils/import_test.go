@@ -0,0 +1,76 @@
+package utils
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/labd/amplience-go-sdk/content"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsAmplienceID(t *testing.T) {
+	assert.True(t, IsAmplienceID("5f7d5f7d5f7d5f7d5f7d5f7d"))
+	assert.False(t, IsAmplienceID("content"))
+	assert.False(t, IsAmplienceID("https://schema.example.com/banner.json"))
+}
+
+func TestFindUnique(t *testing.T) {
+	type item struct{ id, name string }
+	items := []item{{"1", "content"}, {"2", "slots"}, {"3", "slots"}}
+	key := func(i item) string { return i.name }
+	id := func(i item) string { return i.id }
+
+	result, err := FindUnique(items, key, id, "content", "content repository", "name")
+	require.NoError(t, err)
+	assert.Equal(t, "1", result.id)
+
+	_, err = FindUnique(items, key, id, "missing", "content repository", "name")
+	assert.EqualError(t, err, `no content repository found with name "missing"`)
+
+	_, err = FindUnique(items, key, id, "slots", "content repository", "name")
+	assert.EqualError(t, err, `name "slots" matches more than one content repository: 2, 3. Use the ID of one of them instead`)
+}
+
+func TestFindActive(t *testing.T) {
+	type item struct{ id, name, status string }
+	items := []item{{"1", "banner", "ACTIVE"}, {"2", "banner", "ARCHIVED"}, {"3", "card", "ARCHIVED"}}
+	key := func(i item) string { return i.name }
+	id := func(i item) string { return i.id }
+	status := func(i item) string { return i.status }
+
+	result, err := FindActive(items, key, id, status, "banner", "content type", "content_type_uri")
+	require.NoError(t, err)
+	assert.Equal(t, "1", result.id)
+
+	_, err = FindActive(items, key, id, status, "card", "content type", "content_type_uri")
+	assert.EqualError(t, err, `the content type with content_type_uri "card" is archived. Unarchive it in Amplience before importing it`)
+
+	_, err = FindActive(items, key, id, status, "missing", "content type", "content_type_uri")
+	assert.EqualError(t, err, `no content type found with content_type_uri "missing"`)
+}
+
+func TestResolveImportID(t *testing.T) {
+	notFound := &content.ErrorResponse{StatusCode: http.StatusNotFound}
+	resolve := func(key string) (string, error) { return "resolved-" + key, nil }
+
+	id, err := ResolveImportID("5f7d5f7d5f7d5f7d5f7d5f7d", func(string) error { return nil }, resolve)
+	require.NoError(t, err)
+	assert.Equal(t, "5f7d5f7d5f7d5f7d5f7d5f7d", id)
+
+	id, err = ResolveImportID("5f7d5f7d5f7d5f7d5f7d5f7d", func(string) error { return notFound }, resolve)
+	require.NoError(t, err)
+	assert.Equal(t, "resolved-5f7d5f7d5f7d5f7d5f7d5f7d", id)
+
+	_, err = ResolveImportID("5f7d5f7d5f7d5f7d5f7d5f7d", func(string) error { return errors.New("archived") }, resolve)
+	assert.EqualError(t, err, "archived")
+
+	id, err = ResolveImportID("content", func(string) error {
+		t.Error("unexpected get for a key")
+		return nil
+	}, resolve)
+	require.NoError(t, err)
+	assert.Equal(t, "resolved-content", id)
+}
@@ -0,0 +1,149 @@
+package utils
+
+import (
+	"io"
+	"log"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	DefaultMaxRetries   = 3
+	DefaultRetryMinWait = 1 * time.Second
+	DefaultRetryMaxWait = 30 * time.Second
+)
+
+// RetryTransport retries requests that were rejected because of rate limiting (429) or that failed with a server
+// error (5xx). Waiting between attempts uses exponential backoff with jitter, unless the response carries a
+// Retry-After header, in which case that value is honoured (capped at MaxWait).
+//
+// Rate limited requests are retried regardless of their method, since the API did not process them. Server errors
+// and transport errors are only retried for idempotent methods, as the request might have been (partly) handled.
+type RetryTransport struct {
+	Transport  http.RoundTripper
+	MaxRetries int
+	MinWait    time.Duration
+	MaxWait    time.Duration
+}
+
+func (t *RetryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 && req.Body != nil && req.Body != http.NoBody {
+			// The previous attempt consumed the body, so rewind it for the next one.
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req = req.Clone(req.Context())
+			req.Body = body
+		}
+
+		resp, err := t.Transport.RoundTrip(req)
+		if attempt >= t.MaxRetries || !t.shouldRetry(req, resp, err) {
+			return resp, err
+		}
+
+		wait := t.backoff(attempt, resp)
+		if resp != nil {
+			log.Printf("[DEBUG] %s %s returned %d, retrying in %s", req.Method, req.URL, resp.StatusCode, wait)
+			_, _ = io.Copy(io.Discard, resp.Body)
+			_ = resp.Body.Close()
+		} else {
+			log.Printf("[DEBUG] %s %s failed: %s, retrying in %s", req.Method, req.URL, err, wait)
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+}
+
+func (t *RetryTransport) shouldRetry(req *http.Request, resp *http.Response, err error) bool {
+	if req.Context().Err() != nil {
+		return false
+	}
+
+	// A body we cannot rewind cannot be sent again
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return false
+	}
+
+	if err != nil {
+		return isIdempotent(req.Method)
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusTooManyRequests:
+		return true
+	case resp.StatusCode >= 500 && resp.StatusCode != http.StatusNotImplemented:
+		return isIdempotent(req.Method)
+	default:
+		return false
+	}
+}
+
+// backoff returns how long to wait before the next attempt
+func (t *RetryTransport) backoff(attempt int, resp *http.Response) time.Duration {
+	maxWait := t.MaxWait
+	if maxWait <= 0 {
+		maxWait = DefaultRetryMaxWait
+	}
+	minWait := t.MinWait
+	if minWait <= 0 {
+		minWait = DefaultRetryMinWait
+	}
+	if minWait > maxWait {
+		minWait = maxWait
+	}
+
+	if resp != nil {
+		if wait, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			return min(wait, maxWait)
+		}
+	}
+
+	wait := maxWait
+	if attempt < 32 {
+		wait = min(minWait<<attempt, maxWait)
+	}
+
+	// Use "equal jitter": wait at least half of the computed backoff, so concurrent clients spread out
+	// without retrying immediately.
+	half := wait / 2
+	return half + rand.N(wait-half+1)
+}
+
+// parseRetryAfter parses the value of a Retry-After header, which is either a number of seconds or an HTTP date
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		return max(time.Until(date), 0), true
+	}
+
+	return 0, false
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodTrace, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,157 @@
+package utils
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestRetryClient(maxRetries int) *http.Client {
+	return &http.Client{
+		Transport: &RetryTransport{
+			Transport:  http.DefaultTransport,
+			MaxRetries: maxRetries,
+			MinWait:    time.Millisecond,
+			MaxWait:    5 * time.Millisecond,
+		},
+	}
+}
+
+func TestRetryTransport(t *testing.T) {
+	tcs := []struct {
+		Name       string
+		Method     string
+		Statuses   []int
+		MaxRetries int
+		Status     int
+		Attempts   int
+	}{
+		{
+			Name:       "Retries rate limited GET requests",
+			Method:     http.MethodGet,
+			Statuses:   []int{http.StatusTooManyRequests, http.StatusTooManyRequests, http.StatusOK},
+			MaxRetries: 3,
+			Status:     http.StatusOK,
+			Attempts:   3,
+		},
+		{
+			Name:       "Retries rate limited POST requests",
+			Method:     http.MethodPost,
+			Statuses:   []int{http.StatusTooManyRequests, http.StatusCreated},
+			MaxRetries: 3,
+			Status:     http.StatusCreated,
+			Attempts:   2,
+		},
+		{
+			Name:       "Retries server errors for idempotent requests",
+			Method:     http.MethodDelete,
+			Statuses:   []int{http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusNoContent},
+			MaxRetries: 3,
+			Status:     http.StatusNoContent,
+			Attempts:   3,
+		},
+		{
+			Name:       "Does not retry server errors for non-idempotent requests",
+			Method:     http.MethodPatch,
+			Statuses:   []int{http.StatusInternalServerError, http.StatusOK},
+			MaxRetries: 3,
+			Status:     http.StatusInternalServerError,
+			Attempts:   1,
+		},
+		{
+			Name:       "Does not retry client errors",
+			Method:     http.MethodGet,
+			Statuses:   []int{http.StatusNotFound, http.StatusOK},
+			MaxRetries: 3,
+			Status:     http.StatusNotFound,
+			Attempts:   1,
+		},
+		{
+			Name:       "Gives up after max retries",
+			Method:     http.MethodGet,
+			Statuses:   []int{http.StatusTooManyRequests, http.StatusTooManyRequests, http.StatusTooManyRequests},
+			MaxRetries: 2,
+			Status:     http.StatusTooManyRequests,
+			Attempts:   3,
+		},
+		{
+			Name:       "Does not retry when retries are disabled",
+			Method:     http.MethodGet,
+			Statuses:   []int{http.StatusTooManyRequests, http.StatusOK},
+			MaxRetries: 0,
+			Status:     http.StatusTooManyRequests,
+			Attempts:   1,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.Name, func(t *testing.T) {
+			attempts := 0
+			var bodies []string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, _ := io.ReadAll(r.Body)
+				bodies = append(bodies, string(body))
+
+				w.Header().Set("Retry-After", "0")
+				w.WriteHeader(tc.Statuses[attempts])
+				attempts++
+			}))
+			defer server.Close()
+
+			req, err := http.NewRequest(tc.Method, server.URL, bytes.NewBufferString(`{"label":"test"}`))
+			require.NoError(t, err)
+
+			resp, err := newTestRetryClient(tc.MaxRetries).Do(req)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+
+			assert.Equal(t, tc.Status, resp.StatusCode)
+			assert.Equal(t, tc.Attempts, attempts)
+			for _, body := range bodies {
+				assert.Equal(t, `{"label":"test"}`, body)
+			}
+		})
+	}
+}
+
+func TestRetryTransportBackoff(t *testing.T) {
+	transport := &RetryTransport{
+		MinWait: 100 * time.Millisecond,
+		MaxWait: 2 * time.Second,
+	}
+
+	for attempt := 0; attempt < 10; attempt++ {
+		wait := transport.backoff(attempt, nil)
+		expected := min(100*time.Millisecond<<attempt, 2*time.Second)
+		assert.GreaterOrEqual(t, wait, expected/2)
+		assert.LessOrEqual(t, wait, expected)
+	}
+
+	resp := &http.Response{Header: http.Header{"Retry-After": []string{"1"}}}
+	assert.Equal(t, time.Second, transport.backoff(0, resp))
+
+	resp = &http.Response{Header: http.Header{"Retry-After": []string{"120"}}}
+	assert.Equal(t, 2*time.Second, transport.backoff(0, resp), "Retry-After is capped at MaxWait")
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	wait, ok := parseRetryAfter("5")
+	assert.True(t, ok)
+	assert.Equal(t, 5*time.Second, wait)
+
+	wait, ok = parseRetryAfter(time.Now().Add(-time.Minute).UTC().Format(http.TimeFormat))
+	assert.True(t, ok)
+	assert.Equal(t, time.Duration(0), wait)
+
+	_, ok = parseRetryAfter("")
+	assert.False(t, ok)
+
+	_, ok = parseRetryAfter("soon")
+	assert.False(t, ok)
+}
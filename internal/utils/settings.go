@@ -0,0 +1,29 @@
+package utils
+
+import (
+	"encoding/json"
+)
+
+// ManagedSettings returns the JSON object with the values in remote for the keys of the JSON object current. APIs
+// such as the Algolia settings of a search index return a value for every setting, while only the settings in the
+// configuration are managed by Terraform. Keys of current that are not in remote are left out, so the difference
+// shows up in the plan.
+func ManagedSettings(current string, remote map[string]interface{}) (string, error) {
+	var managed map[string]interface{}
+	if err := json.Unmarshal([]byte(current), &managed); err != nil {
+		return "", err
+	}
+
+	settings := map[string]interface{}{}
+	for key := range managed {
+		if value, ok := remote[key]; ok {
+			settings[key] = value
+		}
+	}
+
+	result, err := json.Marshal(settings)
+	if err != nil {
+		return "", err
+	}
+	return string(result), nil
+}
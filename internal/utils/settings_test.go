@@ -0,0 +1,24 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestManagedSettings(t *testing.T) {
+	t.Parallel()
+	remote := map[string]interface{}{
+		"hitsPerPage":          float64(50),
+		"searchableAttributes": []interface{}{"title"},
+		"maxValuesPerFacet":    float64(100),
+	}
+
+	result, err := ManagedSettings(`{"searchableAttributes": ["title", "description"], "hitsPerPage": 20, "unknown": 1}`, remote)
+	require.NoError(t, err)
+	assert.Equal(t, `{"hitsPerPage":50,"searchableAttributes":["title"]}`, result)
+
+	_, err = ManagedSettings(`[]`, remote)
+	assert.Error(t, err)
+}
@@ -0,0 +1,29 @@
+package utils
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+)
+
+// ValidateTimeRange returns an error when end is not after start. Null, unknown and invalid values are not checked,
+// invalid values are reported by the RFC3339 type itself.
+func ValidateTimeRange(start timetypes.RFC3339, end timetypes.RFC3339) error {
+	if start.IsNull() || start.IsUnknown() || end.IsNull() || end.IsUnknown() {
+		return nil
+	}
+
+	startTime, diags := start.ValueRFC3339Time()
+	if diags.HasError() {
+		return nil
+	}
+	endTime, diags := end.ValueRFC3339Time()
+	if diags.HasError() {
+		return nil
+	}
+
+	if !endTime.After(startTime) {
+		return fmt.Errorf("the end %s must be after the start %s", end.ValueString(), start.ValueString())
+	}
+	return nil
+}
@@ -0,0 +1,60 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateTimeRange(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name  string
+		start timetypes.RFC3339
+		end   timetypes.RFC3339
+		error string
+	}{
+		{
+			name:  "valid",
+			start: timetypes.NewRFC3339ValueMust("2026-11-27T00:00:00Z"),
+			end:   timetypes.NewRFC3339ValueMust("2026-11-30T23:59:59Z"),
+		},
+		{
+			name:  "different offsets",
+			start: timetypes.NewRFC3339ValueMust("2026-11-27T01:00:00+02:00"),
+			end:   timetypes.NewRFC3339ValueMust("2026-11-27T00:00:00Z"),
+		},
+		{
+			name:  "end before start",
+			start: timetypes.NewRFC3339ValueMust("2026-11-27T00:00:00Z"),
+			end:   timetypes.NewRFC3339ValueMust("2026-11-26T00:00:00Z"),
+			error: "the end 2026-11-26T00:00:00Z must be after the start 2026-11-27T00:00:00Z",
+		},
+		{
+			name:  "equal",
+			start: timetypes.NewRFC3339ValueMust("2026-11-27T00:00:00Z"),
+			end:   timetypes.NewRFC3339ValueMust("2026-11-27T02:00:00+02:00"),
+			error: "must be after the start",
+		},
+		{
+			name:  "unknown",
+			start: timetypes.NewRFC3339Unknown(),
+			end:   timetypes.NewRFC3339ValueMust("2026-11-26T00:00:00Z"),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := ValidateTimeRange(tc.start, tc.end)
+			if tc.error == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tc.error)
+		})
+	}
+}
@@ -1,6 +1,14 @@
 package utils
 
-import "net/http"
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"time"
+
+	"golang.org/x/oauth2"
+)
 
 type UserAgentTransport struct {
 	UserAgent string
@@ -11,3 +19,66 @@ func (u *UserAgentTransport) RoundTrip(req *http.Request) (*http.Response, error
 	req.Header.Set("User-Agent", u.UserAgent)
 	return u.Transport.RoundTrip(req)
 }
+
+// NewHTTPClient creates the HTTP client used for all calls to the Amplience API. It sets the user agent and retries
+// rate limited and failed requests, see RetryTransport.
+func NewHTTPClient(userAgent string, maxRetries int, maxWait time.Duration) *http.Client {
+	return &http.Client{
+		Transport: &UserAgentTransport{
+			UserAgent: userAgent,
+			Transport: &RetryTransport{
+				Transport:  http.DefaultTransport,
+				MaxRetries: maxRetries,
+				MinWait:    DefaultRetryMinWait,
+				MaxWait:    maxWait,
+			},
+		},
+	}
+}
+
+// TokenSourceTransport answers the OAuth token requests to TokenURL with the token of Source instead of sending them to
+// the authentication server. The Amplience SDK always runs its own client credentials flow, so this lets it share the
+// token of Source with the other clients of the provider. All other requests are sent with Transport.
+type TokenSourceTransport struct {
+	TokenURL  string
+	Source    oauth2.TokenSource
+	Transport http.RoundTripper
+}
+
+func (t *TokenSourceTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.URL.String() != t.TokenURL {
+		return t.Transport.RoundTrip(req)
+	}
+	if req.Body != nil {
+		_ = req.Body.Close()
+	}
+
+	token, err := t.Source.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	body := map[string]interface{}{
+		"access_token": token.AccessToken,
+		"token_type":   token.Type(),
+	}
+	if !token.Expiry.IsZero() {
+		body["expires_in"] = int64(time.Until(token.Expiry).Seconds())
+	}
+	data, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+
+	return &http.Response{
+		StatusCode:    http.StatusOK,
+		Status:        http.StatusText(http.StatusOK),
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": []string{"application/json"}},
+		Body:          io.NopCloser(bytes.NewReader(data)),
+		ContentLength: int64(len(data)),
+		Request:       req,
+	}, nil
+}
@@ -39,7 +39,7 @@ func main() {
 	fullVersion := fmt.Sprintf("%s (%s)", version, commit)
 
 	legacyServerFunc := func() tfprotov6.ProviderServer {
-		legacyServer, err := tf5to6server.UpgradeServer(context.Background(), amplience.Provider().GRPCProvider)
+		legacyServer, err := tf5to6server.UpgradeServer(context.Background(), amplience.Provider(fullVersion).GRPCProvider)
 		if err != nil {
 			log.Fatal(err)
 		}